@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/robbydyer/sports/internal/config"
+	"github.com/robbydyer/sports/pkg/espnboard"
+	"github.com/robbydyer/sports/pkg/sportboard"
+)
+
+type configValidateCmd struct {
+	rArgs   *rootArgs
+	offline bool
+}
+
+func newConfigCmd(args *rootArgs) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Work with config files",
+		// The config file may not load, which is what validate is for
+		PersistentPreRunE: func(cmd *cobra.Command, a []string) error {
+			return args.setLogLevel()
+		},
+	}
+
+	cmd.AddCommand(newConfigValidateCmd(args))
+
+	return cmd
+}
+
+func newConfigValidateCmd(args *rootArgs) *cobra.Command {
+	c := configValidateCmd{
+		rArgs: args,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "validate [file]",
+		Short: "Checks a config file for mistakes",
+		Long: `Checks a config file for mistakes. Defaults to the --config file.
+
+Reports, with line numbers, keys that don't match any setting, values of the wrong type,
+durations and cron schedules that don't parse, hardware mappings that don't exist, and
+watch or favorite teams that aren't in their league. Exits non-zero if there are any.`,
+		Args:         cobra.MaximumNArgs(1),
+		SilenceUsage: true,
+		RunE:         c.run,
+	}
+
+	f := cmd.Flags()
+
+	f.BoolVar(&c.offline, "offline", false, "Don't check teams against each league's team list, which is pulled from the API")
+
+	return cmd
+}
+
+func (c *configValidateCmd) run(cmd *cobra.Command, args []string) error {
+	configFile := viper.GetString("config")
+	if len(args) > 0 {
+		configFile = args[0]
+	}
+
+	data, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		return err
+	}
+
+	v, err := config.Validate(data)
+	if err != nil {
+		return fmt.Errorf("%s is not valid YAML: %w", configFile, err)
+	}
+
+	if v.Config != nil && !c.offline {
+		// Pulling teams logs at info level, which would bury the problems
+		level := c.rArgs.logLevel
+		if level == zapcore.InfoLevel {
+			level = zapcore.WarnLevel
+		}
+		logger, err := c.rArgs.getLogger(level)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if c.rArgs.writer != nil {
+				c.rArgs.writer.Close()
+			}
+		}()
+
+		if err := checkTeams(v, logger); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range v.Sorted() {
+		fmt.Printf("%s: %s\n", configFile, p)
+	}
+
+	if len(v.Problems) > 0 {
+		return fmt.Errorf("%s has %d problem(s)", configFile, len(v.Problems))
+	}
+
+	fmt.Printf("%s is valid\n", configFile)
+
+	return nil
+}
+
+// checkTeams checks each sport's watch and favorite teams against the league's teams
+func checkTeams(v *config.Validation, logger *zap.Logger) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	leagues := []struct {
+		key    string
+		config *sportboard.Config
+		api    func(context.Context, *zap.Logger) (*espnboard.ESPNBoard, error)
+	}{
+		{key: "nhlConfig", config: v.Config.NHLConfig, api: espnboard.NewNHL},
+		{key: "mlbConfig", config: v.Config.MLBConfig, api: espnboard.NewMLB},
+		{key: "ncaamConfig", config: v.Config.NCAAMConfig, api: espnboard.NewNCAAMensBasketball},
+		{key: "ncaafConfig", config: v.Config.NCAAFConfig, api: espnboard.NewNCAAF},
+		{key: "nbaConfig", config: v.Config.NBAConfig, api: espnboard.NewNBA},
+		{key: "nflConfig", config: v.Config.NFLConfig, api: espnboard.NewNFL},
+		{key: "mlsConfig", config: v.Config.MLSConfig, api: espnboard.NewMLS},
+		{key: "eplConfig", config: v.Config.EPLConfig, api: espnboard.NewEPL},
+	}
+
+	for _, league := range leagues {
+		if league.config == nil || (len(league.config.WatchTeams) == 0 && len(league.config.FavoriteTeams) == 0) {
+			continue
+		}
+
+		api, err := league.api(ctx, logger)
+		if err != nil {
+			return err
+		}
+
+		teams, err := api.GetTeams(ctx)
+		if err != nil {
+			logger.Warn("failed to get teams, skipping team checks",
+				zap.String("league", api.League()),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		for i, team := range league.config.WatchTeams {
+			ok, err := api.IsWatchTeam(ctx, team)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				v.Add(fmt.Sprintf("%s.watchTeams[%d]", league.key, i),
+					"unknown %s team or conference %q, see the abbrev command for the team list", api.League(), team,
+				)
+			}
+		}
+
+		// Favorites are matched against team abbreviations only
+		abbrevs := make(map[string]struct{}, len(teams))
+		for _, t := range teams {
+			abbrevs[t.GetAbbreviation()] = struct{}{}
+		}
+		for i, team := range league.config.FavoriteTeams {
+			if _, ok := abbrevs[team]; !ok {
+				v.Add(fmt.Sprintf("%s.favoriteTeams[%d]", league.key, i),
+					"unknown %s team %q, see the abbrev command for the team list", api.League(), team,
+				)
+			}
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"image"
 	"io/ioutil"
 	"os"
+	"strings"
 	"time"
 
 	yaml "github.com/ghodss/yaml"
@@ -18,15 +19,19 @@ import (
 	"github.com/robbydyer/sports/internal/config"
 	"github.com/robbydyer/sports/pkg/board"
 	"github.com/robbydyer/sports/pkg/clock"
+	"github.com/robbydyer/sports/pkg/databoard"
 	"github.com/robbydyer/sports/pkg/espnboard"
 	"github.com/robbydyer/sports/pkg/espnracing"
+	"github.com/robbydyer/sports/pkg/httpclient"
 	"github.com/robbydyer/sports/pkg/imageboard"
 	"github.com/robbydyer/sports/pkg/mlb"
 	"github.com/robbydyer/sports/pkg/nhl"
 	"github.com/robbydyer/sports/pkg/openweather"
 	"github.com/robbydyer/sports/pkg/pga"
+	"github.com/robbydyer/sports/pkg/pluginboard"
 	"github.com/robbydyer/sports/pkg/racingboard"
 	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/sportboard"
 	"github.com/robbydyer/sports/pkg/sportsmatrix"
 	"github.com/robbydyer/sports/pkg/statboard"
@@ -52,6 +57,14 @@ type rootArgs struct {
 	logFile      string
 	writer       *os.File
 	alternateAPI bool
+	loadedConfig string
+	fileConfig   *config.Config
+	reloaders    map[string]configReloader
+	stateStore   *config.StateStore
+	recordDir    string
+	replayDir    string
+	startTime    string
+	speed        float64
 }
 
 func main() {
@@ -95,20 +108,16 @@ func newRootCmd(args *rootArgs) *cobra.Command {
 				args.config = &config.Config{}
 			}
 
-			lvl := viper.GetString("log-level")
-
-			if lvl == "" {
-				args.logLevel = zapcore.InfoLevel
-			} else {
-				var l zapcore.Level
-				if err := l.Set(lvl); err != nil {
-					return err
-				}
-				args.logLevel = l
+			if err := args.setLogLevel(); err != nil {
+				return err
 			}
 
 			args.setConfigDefaults()
 
+			if err := args.setClock(); err != nil {
+				return err
+			}
+
 			return args.setTodayFuncs(viper.GetString("date-str"))
 		},
 	}
@@ -121,6 +130,10 @@ func newRootCmd(args *rootArgs) *cobra.Command {
 	f.StringVar(&args.today, "date-str", "", "Set the date of 'Today' for testing past days. Format 2020-01-30")
 	f.StringVarP(&args.logFile, "log-file", "f", "", "Write logs to given file instead of STDOUT")
 	f.BoolVarP(&args.alternateAPI, "alt-api", "a", false, "Use alternative API's where available")
+	f.StringVar(&args.recordDir, "record-dir", "", "Save every upstream API response to this directory")
+	f.StringVar(&args.replayDir, "replay-dir", "", "Serve upstream API responses saved by --record-dir from this directory instead of the network")
+	f.StringVar(&args.startTime, "start-time", "", "Run on a simulated clock starting at this local time, ie. 2021-10-30T12:00. Defaults to the start of the --replay-dir recording")
+	f.Float64Var(&args.speed, "speed", 1, "Speed of the simulated clock, ie. 10 plays an hour in 6 minutes")
 
 	_ = viper.BindPFlags(f)
 
@@ -131,24 +144,61 @@ func newRootCmd(args *rootArgs) *cobra.Command {
 	rootCmd.AddCommand(newAbbrevCmd(args))
 	rootCmd.AddCommand(newStockCmd(args))
 	rootCmd.AddCommand(newWeatherCmd(args))
+	rootCmd.AddCommand(newRenderCmd(args))
+	rootCmd.AddCommand(newConfigCmd(args))
 
 	return rootCmd
 }
 
+func (r *rootArgs) setLogLevel() error {
+	lvl := viper.GetString("log-level")
+
+	if lvl == "" {
+		r.logLevel = zapcore.InfoLevel
+		return nil
+	}
+
+	var l zapcore.Level
+	if err := l.Set(lvl); err != nil {
+		return err
+	}
+	r.logLevel = l
+
+	return nil
+}
+
 func (r *rootArgs) setConfig(filename string) error {
-	f, err := ioutil.ReadFile(filename)
+	c, err := readConfig(filename)
 	if err != nil {
 		return err
 	}
 
+	r.config = c
+	r.loadedConfig = filename
+	return nil
+}
+
+func readConfig(filename string) (*config.Config, error) {
+	f, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseConfig(f)
+}
+
+func parseConfig(data []byte) (*config.Config, error) {
 	var c *config.Config
 
-	if err := yaml.Unmarshal(f, &c); err != nil {
-		return fmt.Errorf("failed to parse config file: %w", err)
+	if err := yaml.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
 	}
 
-	r.config = c
-	return nil
+	if c == nil {
+		c = &config.Config{}
+	}
+
+	return c, nil
 }
 
 func (r *rootArgs) setConfigDefaults() {
@@ -334,6 +384,18 @@ func (r *rootArgs) setConfigDefaults() {
 		}
 	}
 	r.config.IRLConfig.SetDefaults()
+
+	for _, d := range r.config.DataBoards {
+		if d != nil {
+			d.SetDefaults()
+		}
+	}
+
+	for _, p := range r.config.Plugins {
+		if p != nil {
+			p.SetDefaults()
+		}
+	}
 }
 
 func (r *rootArgs) getRGBMatrix(logger *zap.Logger) (rgb.Matrix, error) {
@@ -347,7 +409,7 @@ func (r *rootArgs) getRGBMatrix(logger *zap.Logger) (rgb.Matrix, error) {
 
 	// If we have configured the http server to listen on a privileged port (like 80),
 	// we need to maintain root permissions
-	if r.config.SportsMatrixConfig.HTTPListenPort < 1024 {
+	if r.privilegedPort() {
 		r.config.SportsMatrixConfig.RuntimeOptions.DropPrivileges = -1
 	}
 
@@ -357,6 +419,71 @@ func (r *rootArgs) getRGBMatrix(logger *zap.Logger) (rgb.Matrix, error) {
 	return matrix, err
 }
 
+// setClock records or replays upstream API responses, and sets the simulated clock
+func (r *rootArgs) setClock() error {
+	if r.recordDir != "" && r.replayDir != "" {
+		return fmt.Errorf("--record-dir and --replay-dir can't be used together")
+	}
+
+	var start time.Time
+	if r.recordDir != "" {
+		fmt.Printf("Recording API responses to %s\n", r.recordDir)
+		if err := httpclient.Record(r.recordDir); err != nil {
+			return err
+		}
+	}
+	if r.replayDir != "" {
+		fmt.Printf("Replaying API responses from %s\n", r.replayDir)
+		first, err := httpclient.Replay(r.replayDir)
+		if err != nil {
+			return err
+		}
+		start = first
+	}
+
+	if r.startTime != "" {
+		var err error
+		start, err = parseStartTime(r.startTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	if start.IsZero() {
+		if r.speed != 1 {
+			return fmt.Errorf("--speed requires --start-time or --replay-dir")
+		}
+		return nil
+	}
+
+	clock, err := simclock.NewSimulated(start, r.speed)
+	if err != nil {
+		return err
+	}
+	simclock.Set(clock)
+	fmt.Printf("Using a simulated clock starting at %s, at %gx speed\n", start.Local().Format(time.RFC3339), r.speed)
+
+	return nil
+}
+
+func parseStartTime(s string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid --start-time %s, format is 2006-01-02T15:04", s)
+}
+
+// privilegedPort returns true if the http server or the https redirect listen on a port below 1024
+func (r *rootArgs) privilegedPort() bool {
+	cfg := r.config.SportsMatrixConfig
+	if cfg.HTTPListenPort < 1024 {
+		return true
+	}
+	return cfg.TLS != nil && cfg.TLS.RedirectPort > 0 && cfg.TLS.RedirectPort < 1024
+}
+
 func (r *rootArgs) getTestMatrix(logger *zap.Logger) rgb.Matrix {
 	logger.Info("initializing console matrix",
 		zap.Int("Cols", r.config.SportsMatrixConfig.HardwareConfig.Cols),
@@ -365,6 +492,23 @@ func (r *rootArgs) getTestMatrix(logger *zap.Logger) rgb.Matrix {
 	return rgb.NewConsoleMatrix(r.config.SportsMatrixConfig.HardwareConfig.Cols, r.config.SportsMatrixConfig.HardwareConfig.Rows, os.Stdout, logger)
 }
 
+func (r *rootArgs) getFileMatrix(fileName string, fps int, logger *zap.Logger) (rgb.Matrix, error) {
+	logger.Info("initializing file matrix",
+		zap.String("file", fileName),
+		zap.Int("Cols", r.config.SportsMatrixConfig.HardwareConfig.Cols),
+		zap.Int("Rows", r.config.SportsMatrixConfig.HardwareConfig.Rows),
+		zap.Int("FPS", fps),
+	)
+
+	// Opening a named pipe blocks until there's a reader
+	f, err := os.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return rgb.NewFileMatrix(r.config.SportsMatrixConfig.HardwareConfig.Cols, r.config.SportsMatrixConfig.HardwareConfig.Rows, fps, f), nil
+}
+
 func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.Board, error) {
 	bounds := image.Rect(0, 0, r.config.SportsMatrixConfig.HardwareConfig.Cols, r.config.SportsMatrixConfig.HardwareConfig.Rows)
 
@@ -396,6 +540,9 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return boards, err
 		}
+		r.addReloader("nhlConfig", func(c *config.Config) ([]string, error) {
+			return b.UpdateConfig(c.NHLConfig)
+		})
 
 		boards = append(boards, b)
 	}
@@ -437,6 +584,9 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return boards, err
 		}
+		r.addReloader("mlbConfig", func(c *config.Config) ([]string, error) {
+			return b.UpdateConfig(c.MLBConfig)
+		})
 
 		boards = append(boards, b)
 	}
@@ -469,6 +619,9 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return boards, err
 		}
+		r.addReloader("ncaamConfig", func(c *config.Config) ([]string, error) {
+			return b.UpdateConfig(c.NCAAMConfig)
+		})
 
 		boards = append(boards, b)
 	}
@@ -494,6 +647,9 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return boards, err
 		}
+		r.addReloader("ncaafConfig", func(c *config.Config) ([]string, error) {
+			return b.UpdateConfig(c.NCAAFConfig)
+		})
 
 		boards = append(boards, b)
 	}
@@ -519,6 +675,9 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		r.addReloader("nbaConfig", func(c *config.Config) ([]string, error) {
+			return b.UpdateConfig(c.NBAConfig)
+		})
 
 		boards = append(boards, b)
 	}
@@ -544,6 +703,9 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		r.addReloader("nflConfig", func(c *config.Config) ([]string, error) {
+			return b.UpdateConfig(c.NFLConfig)
+		})
 
 		boards = append(boards, b)
 	}
@@ -569,6 +731,9 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		r.addReloader("mlsConfig", func(c *config.Config) ([]string, error) {
+			return b.UpdateConfig(c.MLSConfig)
+		})
 
 		boards = append(boards, b)
 	}
@@ -594,6 +759,9 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		r.addReloader("eplConfig", func(c *config.Config) ([]string, error) {
+			return b.UpdateConfig(c.EPLConfig)
+		})
 
 		boards = append(boards, b)
 	}
@@ -666,6 +834,9 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		if err != nil {
 			return nil, err
 		}
+		r.addReloader("stocksConfig", func(c *config.Config) ([]string, error) {
+			return b.UpdateConfig(c.StocksConfig)
+		})
 
 		boards = append(boards, b)
 	}
@@ -682,6 +853,9 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 			if err != nil {
 				return nil, err
 			}
+			r.addReloader("weatherConfig", func(c *config.Config) ([]string, error) {
+				return b.UpdateConfig(c.WeatherConfig)
+			})
 			boards = append(boards, b)
 		}
 	}
@@ -710,6 +884,49 @@ func (r *rootArgs) getBoards(ctx context.Context, logger *zap.Logger) ([]board.B
 		boards = append(boards, b)
 	}
 
+	// Data boards and plugins can't share a name with another board, as their names are used for their API paths
+	names := make(map[string]struct{}, len(boards))
+	for _, b := range boards {
+		names[strings.ToLower(b.Name())] = struct{}{}
+	}
+	uniqueName := func(name string) error {
+		if _, ok := names[strings.ToLower(name)]; ok {
+			return fmt.Errorf("board name '%s' is already used by another board", name)
+		}
+		names[strings.ToLower(name)] = struct{}{}
+		return nil
+	}
+
+	for _, d := range r.config.DataBoards {
+		if d == nil {
+			continue
+		}
+		if err := uniqueName(d.Name); err != nil {
+			return nil, err
+		}
+
+		b, err := databoard.New(logger, d)
+		if err != nil {
+			return nil, err
+		}
+		boards = append(boards, b)
+	}
+
+	for _, p := range r.config.Plugins {
+		if p == nil {
+			continue
+		}
+		if err := uniqueName(p.Name); err != nil {
+			return nil, err
+		}
+
+		b, err := pluginboard.New(logger, p)
+		if err != nil {
+			return nil, err
+		}
+		boards = append(boards, b)
+	}
+
 	return boards, nil
 }
 
@@ -0,0 +1,296 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/config"
+	"github.com/robbydyer/sports/pkg/pluginboard"
+	"github.com/robbydyer/sports/pkg/sportsmatrix"
+)
+
+var configWatchInterval = 5 * time.Second
+
+// configReloader applies a board's section of a newly loaded config. It returns
+// the json names of the fields in that section that the board handles
+type configReloader func(c *config.Config) ([]string, error)
+
+func (r *rootArgs) addReloader(section string, f configReloader) {
+	if r.reloaders == nil {
+		r.reloaders = make(map[string]configReloader)
+	}
+	r.reloaders[section] = f
+}
+
+// loadFileConfig reads the config file as-is, with defaults set. This is the baseline
+// that reloads are compared against, as the live config may be changed at runtime
+func (r *rootArgs) loadFileConfig() (*config.Config, error) {
+	if r.loadedConfig == "" {
+		return nil, fmt.Errorf("no config file was loaded")
+	}
+
+	c, err := readConfig(r.loadedConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	fileArgs := &rootArgs{
+		config: c,
+	}
+	fileArgs.setConfigDefaults()
+
+	return fileArgs.config, nil
+}
+
+func (r *rootArgs) reloadConfig(ctx context.Context) (*sportsmatrix.ReloadReport, error) {
+	if r.fileConfig == nil {
+		return nil, fmt.Errorf("no config file was loaded")
+	}
+
+	newConfig, err := r.loadFileConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	changes := config.Diff(r.fileConfig, newConfig)
+
+	report := &sportsmatrix.ReloadReport{
+		Applied: config.ApplyToggles(r.config, newConfig, changes),
+	}
+
+	applied := make(map[string]struct{})
+	for _, path := range report.Applied {
+		applied[path] = struct{}{}
+	}
+
+	sectionChanges := make(map[string][]string)
+	for _, path := range changes {
+		if _, ok := applied[path]; ok {
+			continue
+		}
+		i := strings.LastIndex(path, ".")
+		if i < 0 {
+			report.RestartRequired = append(report.RestartRequired, path)
+			continue
+		}
+		section := path[:i]
+		if _, ok := r.reloaders[section]; !ok {
+			report.RestartRequired = append(report.RestartRequired, path)
+			continue
+		}
+		sectionChanges[section] = append(sectionChanges[section], path[i+1:])
+	}
+
+	sections := make([]string, 0, len(sectionChanges))
+	for section := range sectionChanges {
+		sections = append(sections, section)
+	}
+	sort.Strings(sections)
+
+	for _, section := range sections {
+		handled, err := r.reloaders[section](newConfig)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: %s", section, err.Error()))
+		}
+
+	FIELDS:
+		for _, field := range sectionChanges[section] {
+			path := fmt.Sprintf("%s.%s", section, field)
+			for _, h := range handled {
+				if h == field {
+					report.Applied = append(report.Applied, path)
+					continue FIELDS
+				}
+			}
+			report.RestartRequired = append(report.RestartRequired, path)
+		}
+	}
+
+	// Changes made in the config file take precedence over previously persisted API changes
+	if r.stateStore != nil {
+		r.stateStore.Forget(report.Applied...)
+	}
+
+	r.fileConfig = newConfig
+
+	return report, nil
+}
+
+// configEditor implements the Config RPC service's access to the config
+type configEditor struct {
+	rArgs *rootArgs
+}
+
+// Config returns the live config, with secrets redacted
+func (c *configEditor) Config() ([]byte, error) {
+	return config.RedactedJSON(c.rArgs.config)
+}
+
+// Schema ...
+func (c *configEditor) Schema() ([]byte, error) {
+	return json.Marshal(config.Schema())
+}
+
+// Update merges a partial config into the config file, and reloads it. Problems that were already
+// in the file don't block an update
+func (c *configEditor) Update(ctx context.Context, patch []byte) (*sportsmatrix.ReloadReport, error) {
+	r := c.rArgs
+	if r.loadedConfig == "" {
+		return nil, fmt.Errorf("no config file was loaded")
+	}
+
+	data, err := ioutil.ReadFile(r.loadedConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := config.Merge(data, patch)
+	if err != nil {
+		return nil, &sportsmatrix.InvalidConfigError{
+			Problems: []string{err.Error()},
+		}
+	}
+
+	if problems := pluginProgramChanges(data, merged); len(problems) > 0 {
+		return nil, &sportsmatrix.InvalidConfigError{
+			Problems: problems,
+		}
+	}
+
+	before, err := config.Validate(data)
+	if err != nil {
+		return nil, err
+	}
+	after, err := config.Validate(merged)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]struct{}, len(before.Problems))
+	for _, p := range before.Problems {
+		existing[p.Path+p.Message] = struct{}{}
+	}
+	invalid := &sportsmatrix.InvalidConfigError{}
+	for _, p := range after.Sorted() {
+		if _, ok := existing[p.Path+p.Message]; !ok {
+			invalid.Problems = append(invalid.Problems, p.String())
+		}
+	}
+	if len(invalid.Problems) > 0 {
+		return nil, invalid
+	}
+
+	if err := config.WriteFile(r.loadedConfig, merged); err != nil {
+		return nil, fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	return r.reloadConfig(ctx)
+}
+
+// pluginProgramChanges reports changes to the programs that plugin boards run. Plugins run with the
+// service's privileges, so these can only be changed by editing the config file, not over the API
+func pluginProgramChanges(data []byte, merged []byte) []string {
+	before, err := parseConfig(data)
+	if err != nil {
+		// The file's own problems are reported by validation
+		return nil
+	}
+	after, err := parseConfig(merged)
+	if err != nil {
+		return nil
+	}
+
+	existing := make(map[string]*pluginboard.Config, len(before.Plugins))
+	for _, p := range before.Plugins {
+		if p != nil {
+			existing[p.Name] = p
+		}
+	}
+
+	var problems []string
+	for i, p := range after.Plugins {
+		if p == nil {
+			continue
+		}
+		old, ok := existing[p.Name]
+		if !ok {
+			old = &pluginboard.Config{}
+		}
+		if !sameArgs(p.Command, old.Command) {
+			problems = append(problems, fmt.Sprintf("plugins[%d].command: can only be changed in the config file", i))
+		}
+		if p.Socket != old.Socket {
+			problems = append(problems, fmt.Sprintf("plugins[%d].socket: can only be changed in the config file", i))
+		}
+	}
+
+	return problems
+}
+
+func sameArgs(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// watchConfig reloads the config whenever the config file is modified
+func (r *rootArgs) watchConfig(ctx context.Context, logger *zap.Logger, mtrx *sportsmatrix.SportsMatrix) {
+	info, err := os.Stat(r.loadedConfig)
+	if err != nil {
+		logger.Error("failed to watch config file",
+			zap.String("file", r.loadedConfig),
+			zap.Error(err),
+		)
+		return
+	}
+	lastMod := info.ModTime()
+
+	ticker := time.NewTicker(configWatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(r.loadedConfig)
+		if err != nil || !info.ModTime().After(lastMod) {
+			continue
+		}
+		lastMod = info.ModTime()
+
+		logger.Info("config file changed, reloading",
+			zap.String("file", r.loadedConfig),
+		)
+
+		report, err := mtrx.ReloadConfig(ctx)
+		if err != nil {
+			logger.Error("failed to reload config",
+				zap.Error(err),
+			)
+			continue
+		}
+
+		logger.Info("reloaded config",
+			zap.Strings("applied", report.Applied),
+			zap.Strings("restart required", report.RestartRequired),
+			zap.Strings("errors", report.Errors),
+		)
+	}
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/disintegration/imaging"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/board"
+	"github.com/robbydyer/sports/pkg/httpclient"
+	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
+	"github.com/robbydyer/sports/pkg/rgbrender"
+)
+
+type renderCmd struct {
+	rArgs    *rootArgs
+	size     string
+	outDir   string
+	duration time.Duration
+	frames   int
+	gif      bool
+	scale    int
+	scroll   bool
+	padding  int
+}
+
+func newRenderCmd(args *rootArgs) *cobra.Command {
+	c := renderCmd{
+		rArgs: args,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "render [board...]",
+		Short: "Renders configured boards headless to PNG/GIF files, at any matrix size",
+		Long: `Renders configured boards headless to PNG/GIF files, at any matrix size.
+
+Boards are named as they are in the API, ie. NHL or Weather, and are rendered even if
+they're disabled in the config. Each frame is written to <out-dir>/<board>-<size>-<n>.png.
+With --scroll, the board's whole scroll canvas is written to <out-dir>/<board>-<size>-scroll.png`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: c.run,
+	}
+
+	f := cmd.Flags()
+
+	f.StringVar(&c.size, "size", "64x32", "Matrix size to render at, as WIDTHxHEIGHT, ie. 128x64")
+	f.StringVarP(&c.outDir, "out-dir", "o", ".", "Directory to write images to")
+	f.DurationVar(&c.duration, "duration", 30*time.Second, "Maximum time to render each board for")
+	f.IntVar(&c.frames, "frames", 0, "Maximum number of frames to write per board. 0 writes all of them")
+	f.BoolVar(&c.gif, "gif", false, "Write the frames as an animated GIF, <out-dir>/<board>-<size>.gif, instead of PNGs")
+	f.IntVar(&c.scale, "scale", 1, "Factor to upscale the images by")
+	f.BoolVar(&c.scroll, "scroll", false, "Render the board's scroll mode canvas to a single PNG")
+	f.IntVar(&c.padding, "padding", 8, "Padding between items with --scroll")
+
+	return cmd
+}
+
+func (c *renderCmd) run(cmd *cobra.Command, args []string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	width, height, err := parseSize(c.size)
+	if err != nil {
+		return err
+	}
+	if c.scale < 1 {
+		c.scale = 1
+	}
+
+	logger, err := c.rArgs.getLogger(c.rArgs.logLevel)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if c.rArgs.writer != nil {
+			c.rArgs.writer.Close()
+		}
+	}()
+
+	httpclient.Configure(c.rArgs.config.HTTPClient)
+
+	// Boards size themselves from the hardware config
+	c.rArgs.config.SportsMatrixConfig.HardwareConfig.Cols = width
+	c.rArgs.config.SportsMatrixConfig.HardwareConfig.Rows = height
+
+	boards, err := c.rArgs.getBoards(ctx, logger)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.outDir, 0o755); err != nil {
+		return err
+	}
+
+	for _, name := range args {
+		b := findBoard(boards, name)
+		if b == nil {
+			var names []string
+			for _, b := range boards {
+				names = append(names, b.Name())
+			}
+			return fmt.Errorf("no board named %s, choose from %s", name, strings.Join(names, ", "))
+		}
+		b.Enable()
+
+		prefix := filepath.Join(c.outDir, fmt.Sprintf("%s-%dx%d", strings.ToLower(b.Name()), width, height))
+
+		if c.scroll {
+			err = c.renderScroll(ctx, b, width, height, prefix, logger)
+		} else {
+			err = c.renderFrames(ctx, b, width, height, prefix, logger)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to render %s: %w", b.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+// renderFrames records the board rendering to an in-memory matrix until it's done a full rotation, or
+// the duration passes
+func (c *renderCmd) renderFrames(ctx context.Context, b board.Board, width int, height int, prefix string, logger *zap.Logger) error {
+	matrix := rgb.NewRecordingMatrix(rgb.NewMemoryMatrix(width, height))
+	canvas := rgb.NewCanvas(matrix)
+
+	renderCtx, cancel := context.WithTimeout(ctx, c.duration)
+	defer cancel()
+
+	done, err := matrix.StartRecording(renderCtx, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("rendering board",
+		zap.String("board", b.Name()),
+		zap.Int("width", width),
+		zap.Int("height", height),
+	)
+	if err := b.Render(renderCtx, canvas); err != nil && renderCtx.Err() == nil {
+		return err
+	}
+	matrix.StopRecording()
+
+	frames := <-done
+	if len(frames) < 1 {
+		return fmt.Errorf("no frames were rendered")
+	}
+	if c.frames > 0 && len(frames) > c.frames {
+		frames = frames[:c.frames]
+	}
+
+	if c.gif {
+		fileName := prefix + ".gif"
+		if err := rgbrender.SaveGif(rgb.FramesToGif(frames, c.scale), fileName); err != nil {
+			return err
+		}
+		fmt.Printf("Wrote %d frames to %s\n", len(frames), fileName)
+		return nil
+	}
+
+	for i, f := range frames {
+		fileName := fmt.Sprintf("%s-%03d.png", prefix, i)
+		if err := rgbrender.SavePng(scaleImage(f.Image, c.scale), fileName); err != nil {
+			return err
+		}
+		fmt.Printf("Wrote %s\n", fileName)
+	}
+
+	return nil
+}
+
+func (c *renderCmd) renderScroll(ctx context.Context, b board.Board, width int, height int, prefix string, logger *zap.Logger) error {
+	base, err := rgb.NewScrollCanvas(rgb.NewMemoryMatrix(width, height), logger,
+		rgb.WithScrollDirection(rgb.RightToLeft),
+	)
+	if err != nil {
+		return err
+	}
+
+	renderCtx, cancel := context.WithTimeout(ctx, c.duration)
+	defer cancel()
+
+	logger.Info("rendering board scroll canvas",
+		zap.String("board", b.Name()),
+		zap.Int("width", width),
+		zap.Int("height", height),
+	)
+	canvas, err := b.ScrollRender(renderCtx, base, c.padding)
+	if err != nil {
+		return err
+	}
+	scroll, ok := canvas.(*rgb.ScrollCanvas)
+	if !ok || scroll.Len() < 1 {
+		return fmt.Errorf("board has nothing to scroll")
+	}
+	scroll.Merge(c.padding)
+
+	fileName := prefix + "-scroll.png"
+	if err := rgbrender.SavePng(scaleImage(cropScroll(scroll.GetActual(), height), c.scale), fileName); err != nil {
+		return err
+	}
+	fmt.Printf("Wrote %s\n", fileName)
+
+	return nil
+}
+
+func findBoard(boards []board.Board, name string) board.Board {
+	for _, b := range boards {
+		if strings.EqualFold(b.Name(), name) {
+			return b
+		}
+	}
+
+	return nil
+}
+
+// parseSize parses a matrix size, ie. 64x32
+func parseSize(size string) (int, int, error) {
+	var width, height int
+	if _, err := fmt.Sscanf(strings.ToLower(size), "%dx%d", &width, &height); err != nil || width < 1 || height < 1 {
+		return 0, 0, fmt.Errorf("invalid size %s, format is WIDTHxHEIGHT", size)
+	}
+
+	return width, height, nil
+}
+
+// cropScroll crops a merged scroll canvas to the rows shown on the matrix, from its first to last lit
+// column, on a black background
+func cropScroll(img *image.RGBA, height int) image.Image {
+	minX, maxX := img.Bounds().Max.X, img.Bounds().Min.X-1
+	for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x++ {
+		for y := 0; y < height; y++ {
+			if r, g, b, _ := img.At(x, y).RGBA(); r|g|b == 0 {
+				continue
+			}
+			if x < minX {
+				minX = x
+			}
+			maxX = x
+			break
+		}
+	}
+	if maxX < minX {
+		minX, maxX = 0, 0
+	}
+
+	bounds := image.Rect(0, 0, maxX-minX+1, height)
+	cropped := image.NewRGBA(bounds)
+	draw.Draw(cropped, bounds, &image.Uniform{color.Black}, image.Point{}, draw.Src)
+	draw.Draw(cropped, bounds, img, image.Pt(minX, 0), draw.Over)
+
+	return cropped
+}
+
+// scaleImage upscales an image without smoothing, so each LED stays a sharp square
+func scaleImage(img image.Image, scale int) image.Image {
+	if scale <= 1 {
+		return img
+	}
+
+	return imaging.Resize(img, img.Bounds().Dx()*scale, img.Bounds().Dy()*scale, imaging.NearestNeighbor)
+}
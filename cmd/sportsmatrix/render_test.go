@@ -0,0 +1,101 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		size   string
+		width  int
+		height int
+		err    bool
+	}{
+		{size: "128x64", width: 128, height: 64},
+		{size: "64X32", width: 64, height: 32},
+		{size: "0x32", err: true},
+		{size: "64x0", err: true},
+		{size: "64", err: true},
+		{size: "bigxsmall", err: true},
+		{size: "", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.size, func(t *testing.T) {
+			w, h, err := parseSize(test.size)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.width, w)
+			require.Equal(t, test.height, h)
+		})
+	}
+}
+
+func TestCropScroll(t *testing.T) {
+	red := color.RGBA{R: 255, A: 255}
+	black := color.RGBA{A: 255}
+
+	t.Run("all black", func(t *testing.T) {
+		img := image.NewRGBA(image.Rect(0, 0, 40, 8))
+
+		cropped := cropScroll(img, 4)
+		require.Equal(t, image.Rect(0, 0, 1, 4), cropped.Bounds())
+		require.Equal(t, black, cropped.At(0, 0))
+	})
+
+	t.Run("single column", func(t *testing.T) {
+		img := image.NewRGBA(image.Rect(0, 0, 40, 8))
+		img.Set(10, 0, red)
+		img.Set(10, 3, red)
+		// Rows below the matrix height aren't shown, so don't widen the crop
+		img.Set(30, 6, red)
+
+		cropped := cropScroll(img, 4)
+		require.Equal(t, image.Rect(0, 0, 1, 4), cropped.Bounds())
+		require.Equal(t, red, cropped.At(0, 0))
+		require.Equal(t, black, cropped.At(0, 1))
+		require.Equal(t, red, cropped.At(0, 3))
+	})
+
+	t.Run("multiple columns", func(t *testing.T) {
+		img := image.NewRGBA(image.Rect(0, 0, 40, 8))
+		img.Set(5, 1, red)
+		img.Set(12, 2, red)
+
+		cropped := cropScroll(img, 4)
+		require.Equal(t, image.Rect(0, 0, 8, 4), cropped.Bounds())
+		require.Equal(t, red, cropped.At(0, 1))
+		require.Equal(t, black, cropped.At(3, 1))
+		require.Equal(t, red, cropped.At(7, 2))
+	})
+}
+
+func TestScaleImage(t *testing.T) {
+	red := color.NRGBA{R: 255, A: 255}
+	blue := color.NRGBA{B: 255, A: 255}
+
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, red)
+	img.Set(1, 0, blue)
+
+	require.Equal(t, img, scaleImage(img, 1))
+	require.Equal(t, img, scaleImage(img, 0))
+
+	scaled := scaleImage(img, 3)
+	require.Equal(t, image.Rect(0, 0, 6, 3), scaled.Bounds())
+
+	// Each pixel becomes a sharp 3x3 square
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 3; x++ {
+			require.Equal(t, red, color.NRGBAModel.Convert(scaled.At(x, y)))
+			require.Equal(t, blue, color.NRGBAModel.Convert(scaled.At(x+3, y)))
+		}
+	}
+}
@@ -7,18 +7,29 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
 	"github.com/robbydyer/sports/pkg/board"
+	"github.com/robbydyer/sports/pkg/httpclient"
 	"github.com/robbydyer/sports/pkg/imageboard"
 	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
+	"github.com/robbydyer/sports/pkg/sportboard"
 	"github.com/robbydyer/sports/pkg/sportsmatrix"
+	"github.com/robbydyer/sports/pkg/webhook"
 )
 
 type runCmd struct {
-	rArgs *rootArgs
+	rArgs          *rootArgs
+	watchConfig    bool
+	stateFile      string
+	record         string
+	recordDuration time.Duration
+	recordScale    int
+	rawOut         string
+	rawFPS         int
 }
 
 func newRunCmd(args *rootArgs) *cobra.Command {
@@ -32,6 +43,16 @@ func newRunCmd(args *rootArgs) *cobra.Command {
 		RunE:  c.run,
 	}
 
+	f := cmd.Flags()
+
+	f.BoolVar(&c.watchConfig, "watch-config", false, "Reload the config file when it changes")
+	f.StringVar(&c.stateFile, "state-file", "", "File for persisting settings changed via the API. Defaults to a file next to the config file")
+	f.StringVar(&c.record, "record", "", "Record the matrix output to the given animated GIF file")
+	f.DurationVar(&c.recordDuration, "record-duration", 0, "How long to record for with --record. Defaults to recording until stopped, up to 10 minutes")
+	f.IntVar(&c.recordScale, "record-scale", 1, "Factor to upscale the --record GIF by")
+	f.StringVar(&c.rawOut, "raw-out", "", "Write frames as raw 24-bit RGB to the given file or named pipe instead of the LED matrix, ie. for a video encoder")
+	f.IntVar(&c.rawFPS, "raw-fps", 30, "Frames per second written to --raw-out")
+
 	return cmd
 }
 
@@ -57,14 +78,55 @@ func (s *runCmd) run(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	if s.rArgs.loadedConfig != "" {
+		s.rArgs.fileConfig, err = s.rArgs.loadFileConfig()
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := s.rArgs.loadState(s.stateFile, logger); err != nil {
+		return err
+	}
+
+	httpclient.Configure(s.rArgs.config.HTTPClient)
+
 	boards, err := s.rArgs.getBoards(ctx, logger)
 	if err != nil {
 		return err
 	}
 
+	if len(s.rArgs.config.Webhooks) > 0 {
+		notifier, err := webhook.New(s.rArgs.config.Webhooks, logger)
+		if err != nil {
+			return err
+		}
+		for _, b := range boards {
+			if sb, ok := b.(*sportboard.SportBoard); ok {
+				go sb.WatchGameEvents(ctx, notifier.Notify)
+			}
+		}
+	}
+
+	// The cert must be loaded before the matrix drops root privileges
+	if tlsCfg := s.rArgs.config.SportsMatrixConfig.TLS; tlsCfg != nil {
+		if tlsCfg.CertFile == "" && tlsCfg.KeyFile == "" {
+			tlsCfg.CertFile = s.rArgs.configSibling("crt")
+			tlsCfg.KeyFile = s.rArgs.configSibling("key")
+		}
+		if err := tlsCfg.Load(logger); err != nil {
+			return err
+		}
+	}
+
 	var canvases []board.Canvas
 	var matrix rgb.Matrix
-	if s.rArgs.test {
+	if s.rawOut != "" {
+		matrix, err = s.rArgs.getFileMatrix(s.rawOut, s.rawFPS, logger)
+		if err != nil {
+			return err
+		}
+	} else if s.rArgs.test {
 		matrix = s.rArgs.getTestMatrix(logger)
 	} else {
 		var err error
@@ -74,6 +136,30 @@ func (s *runCmd) run(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	var powerLimiter *rgb.PowerLimitMatrix
+	if limit := s.rArgs.config.SportsMatrixConfig.PowerLimit; limit != nil {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+		powerLimiter = rgb.NewPowerLimitMatrix(matrix, limit, s.rArgs.config.SportsMatrixConfig.HardwareConfig.Brightness)
+		matrix = powerLimiter
+	}
+
+	recorder := rgb.NewRecordingMatrix(matrix)
+	matrix = recorder
+
+	var zones []*zoneSetup
+	if len(s.rArgs.config.SportsMatrixConfig.Zones) > 0 {
+		defer matrix.Close()
+
+		var mainZone rgb.Matrix
+		mainZone, zones, boards, err = s.setupZones(matrix, boards, logger)
+		if err != nil {
+			return err
+		}
+		matrix = mainZone
+	}
+
 	scroll, err := rgb.NewScrollCanvas(matrix, logger)
 	if err != nil {
 		return err
@@ -111,6 +197,23 @@ func (s *runCmd) run(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	for _, z := range zones {
+		mtrx.AddZone(z.name, z.canvases, z.boards...)
+	}
+
+	mtrx.SetConfigReloader(s.rArgs.reloadConfig)
+	mtrx.SetConfigEditor(&configEditor{rArgs: s.rArgs})
+	mtrx.SetStateRecorder(s.rArgs.stateStore)
+	mtrx.SetMatrixRecorder(recorder)
+	mtrx.SetDimmer(recorder)
+	if powerLimiter != nil {
+		mtrx.SetPowerMeter(powerLimiter)
+	}
+
+	if s.watchConfig && s.rArgs.loadedConfig != "" {
+		go s.rArgs.watchConfig(ctx, logger, mtrx)
+	}
+
 	for _, brd := range inBetweenBoards {
 		logger.Info("Registering in-between board",
 			zap.String("board", brd.Name()),
@@ -118,6 +221,22 @@ func (s *runCmd) run(cmd *cobra.Command, args []string) error {
 		mtrx.AddBetweenBoard(brd)
 	}
 
+	recordDone := make(chan struct{})
+	if s.record != "" {
+		go func() {
+			defer close(recordDone)
+			if err := mtrx.Record(ctx, s.recordDuration, s.recordScale, s.record); err != nil {
+				logger.Error("failed to record matrix",
+					zap.Error(err),
+				)
+			}
+		}()
+		// Wait for the recording to be saved when shutting down
+		defer func() {
+			<-recordDone
+		}()
+	}
+
 	logger.Info("Starting matrix service")
 	if err := mtrx.Serve(ctx); err != nil {
 		logger.Error("Matrix returned an error",
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/internal/config"
+)
+
+// loadState restores settings that were changed via the API before the last restart
+func (r *rootArgs) loadState(stateFile string, logger *zap.Logger) error {
+	if stateFile == "" {
+		stateFile = r.configSibling("state.json")
+	}
+
+	store, err := config.NewStateStore(stateFile, r.config, logger)
+	if err != nil {
+		return fmt.Errorf("failed to load state file: %w", err)
+	}
+
+	if err := r.chownStateFile(stateFile); err != nil {
+		logger.Error("failed to set ownership of state file, settings may not be saved",
+			zap.String("file", stateFile),
+			zap.Error(err),
+		)
+	}
+
+	restored := store.Restore()
+	logger.Info("restored settings from state file",
+		zap.String("file", stateFile),
+		zap.Strings("settings", restored),
+	)
+
+	r.stateStore = store
+
+	return nil
+}
+
+// configSibling returns a file path next to the config file, ie. /etc/sportsmatrix.state.json
+func (r *rootArgs) configSibling(suffix string) string {
+	configFile := r.configFile
+	if configFile == "" {
+		configFile = defaultConfigFile
+	}
+	return fmt.Sprintf("%s.%s", strings.TrimSuffix(configFile, filepath.Ext(configFile)), suffix)
+}
+
+// chownStateFile makes the state file writable after the matrix drops root
+// privileges to the daemon user
+func (r *rootArgs) chownStateFile(stateFile string) error {
+	if os.Geteuid() != 0 || r.test {
+		return nil
+	}
+	opts := r.config.SportsMatrixConfig.RuntimeOptions
+	if opts == nil || opts.DropPrivileges != 1 || r.privilegedPort() {
+		return nil
+	}
+
+	u, err := user.Lookup("daemon")
+	if err != nil {
+		return err
+	}
+	uid, err := strconv.Atoi(u.Uid)
+	if err != nil {
+		return err
+	}
+	gid, err := strconv.Atoi(u.Gid)
+	if err != nil {
+		return err
+	}
+
+	return os.Chown(stateFile, uid, gid)
+}
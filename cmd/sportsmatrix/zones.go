@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/board"
+	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
+	"github.com/robbydyer/sports/pkg/sportsmatrix"
+)
+
+type zoneSetup struct {
+	name     string
+	canvases []board.Canvas
+	boards   []board.Board
+}
+
+// setupZones splits the matrix into the configured zones. It returns the matrix for the
+// main rotation zone, the other zones and the boards that remain in the main rotation
+func (s *runCmd) setupZones(matrix rgb.Matrix, boards []board.Board, logger *zap.Logger) (rgb.Matrix, []*zoneSetup, []board.Board, error) {
+	w, h := matrix.Geometry()
+
+	var mainZone *sportsmatrix.Zone
+	var others []*sportsmatrix.Zone
+	var bounds []image.Rectangle
+
+	for _, z := range s.rArgs.config.SportsMatrixConfig.Zones {
+		if len(z.Boards) < 1 {
+			if mainZone != nil {
+				return nil, nil, nil, fmt.Errorf("zones %s and %s both have no boards, only the main zone can", mainZone.Name, z.Name)
+			}
+			mainZone = z
+			continue
+		}
+		others = append(others, z)
+	}
+
+	if mainZone == nil {
+		return nil, nil, nil, fmt.Errorf("one zone must have no boards listed, to be used for the main rotation")
+	}
+
+	// The main zone is always first
+	for _, z := range append([]*sportsmatrix.Zone{mainZone}, others...) {
+		bounds = append(bounds, z.Bounds(w, h))
+	}
+
+	zoneMatrices, err := rgb.NewZoneMatrices(matrix, bounds...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var zones []*zoneSetup
+	inZone := make(map[board.Board]struct{})
+
+	for i, z := range others {
+		zm := zoneMatrices[i+1]
+
+		setup := &zoneSetup{
+			name: z.Name,
+		}
+
+	NAMES:
+		for _, name := range z.Boards {
+			for _, b := range boards {
+				if strings.EqualFold(b.Name(), name) {
+					setup.boards = append(setup.boards, b)
+					inZone[b] = struct{}{}
+					continue NAMES
+				}
+			}
+			logger.Warn("zone board does not exist",
+				zap.String("zone", z.Name),
+				zap.String("board", name),
+			)
+		}
+
+		scroll, err := rgb.NewScrollCanvas(zm, logger)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		setup.canvases = []board.Canvas{rgb.NewCanvas(zm), scroll}
+
+		logger.Info("initialized zone",
+			zap.String("zone", z.Name),
+			zap.String("bounds", zm.Bounds().String()),
+		)
+
+		zones = append(zones, setup)
+	}
+
+	var mainBoards []board.Board
+	for _, b := range boards {
+		if _, ok := inZone[b]; !ok {
+			mainBoards = append(mainBoards, b)
+		}
+	}
+
+	logger.Info("initialized main zone",
+		zap.String("zone", mainZone.Name),
+		zap.String("bounds", zoneMatrices[0].Bounds().String()),
+	)
+
+	return zoneMatrices[0], zones, mainBoards, nil
+}
@@ -4,6 +4,7 @@ go 1.16
 
 require (
 	github.com/disintegration/imaging v1.6.2
+	github.com/eclipse/paho.mqtt.golang v1.3.5
 	github.com/ghodss/yaml v1.0.0
 	github.com/go-bridget/twirp-swagger-gen v0.0.0-20220217142614-1844ed3995ef
 	github.com/go-gl/glfw/v3.3/glfw v0.0.0-20201108214237-06ea97f0c265 // indirect
@@ -33,4 +34,5 @@ require (
 	google.golang.org/protobuf v1.27.1
 	gopkg.in/check.v1 v1.0.0-20200902074654-038fdea0a05b
 	gopkg.in/yaml.v2 v2.4.0
+	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b
 )
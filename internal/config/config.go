@@ -2,7 +2,10 @@ package config
 
 import (
 	"github.com/robbydyer/sports/pkg/clock"
+	"github.com/robbydyer/sports/pkg/databoard"
+	"github.com/robbydyer/sports/pkg/httpclient"
 	"github.com/robbydyer/sports/pkg/imageboard"
+	"github.com/robbydyer/sports/pkg/pluginboard"
 	"github.com/robbydyer/sports/pkg/racingboard"
 	"github.com/robbydyer/sports/pkg/sportboard"
 	"github.com/robbydyer/sports/pkg/sportsmatrix"
@@ -10,26 +13,31 @@ import (
 	"github.com/robbydyer/sports/pkg/stockboard"
 	"github.com/robbydyer/sports/pkg/sysboard"
 	"github.com/robbydyer/sports/pkg/weatherboard"
+	"github.com/robbydyer/sports/pkg/webhook"
 )
 
 // Config holds configuration for the RGB matrix and all of its supported Boards
 type Config struct {
-	EnableNHL          bool                 `json:"enableNHL,omitempty"`
-	NHLConfig          *sportboard.Config   `json:"nhlConfig,omitempty"`
-	MLBConfig          *sportboard.Config   `json:"mlbConfig,omitempty"`
-	NCAAMConfig        *sportboard.Config   `json:"ncaamConfig,omitempty"`
-	NCAAFConfig        *sportboard.Config   `json:"ncaafConfig,omitempty"`
-	NBAConfig          *sportboard.Config   `json:"nbaConfig,omitempty"`
-	NFLConfig          *sportboard.Config   `json:"nflConfig,omitempty"`
-	MLSConfig          *sportboard.Config   `json:"mlsConfig,omitempty"`
-	EPLConfig          *sportboard.Config   `json:"eplConfig,omitempty"`
-	ImageConfig        *imageboard.Config   `json:"imageConfig"`
-	ClockConfig        *clock.Config        `json:"clockConfig"`
-	SysConfig          *sysboard.Config     `json:"sysConfig"`
-	PGA                *statboard.Config    `json:"pga"`
-	SportsMatrixConfig *sportsmatrix.Config `json:"sportsMatrixConfig,omitempty"`
-	StocksConfig       *stockboard.Config   `json:"stocksConfig"`
-	WeatherConfig      *weatherboard.Config `json:"weatherConfig"`
-	F1Config           *racingboard.Config  `json:"f1Config"`
-	IRLConfig          *racingboard.Config  `json:"irlConfig"`
+	EnableNHL          bool                  `json:"enableNHL,omitempty"`
+	NHLConfig          *sportboard.Config    `json:"nhlConfig,omitempty"`
+	MLBConfig          *sportboard.Config    `json:"mlbConfig,omitempty"`
+	NCAAMConfig        *sportboard.Config    `json:"ncaamConfig,omitempty"`
+	NCAAFConfig        *sportboard.Config    `json:"ncaafConfig,omitempty"`
+	NBAConfig          *sportboard.Config    `json:"nbaConfig,omitempty"`
+	NFLConfig          *sportboard.Config    `json:"nflConfig,omitempty"`
+	MLSConfig          *sportboard.Config    `json:"mlsConfig,omitempty"`
+	EPLConfig          *sportboard.Config    `json:"eplConfig,omitempty"`
+	ImageConfig        *imageboard.Config    `json:"imageConfig"`
+	ClockConfig        *clock.Config         `json:"clockConfig"`
+	SysConfig          *sysboard.Config      `json:"sysConfig"`
+	PGA                *statboard.Config     `json:"pga"`
+	SportsMatrixConfig *sportsmatrix.Config  `json:"sportsMatrixConfig,omitempty"`
+	StocksConfig       *stockboard.Config    `json:"stocksConfig"`
+	WeatherConfig      *weatherboard.Config  `json:"weatherConfig"`
+	F1Config           *racingboard.Config   `json:"f1Config"`
+	IRLConfig          *racingboard.Config   `json:"irlConfig"`
+	Webhooks           []*webhook.Hook       `json:"webhooks"`
+	DataBoards         []*databoard.Config   `json:"dataBoards"`
+	Plugins            []*pluginboard.Config `json:"plugins"`
+	HTTPClient         *httpclient.Config    `json:"httpClient"`
 }
@@ -0,0 +1,356 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// Merge applies a partial config, as a JSON merge patch (RFC 7386), to a YAML config file. Objects are
+// merged, null values remove a setting, and anything else replaces it. Only the lines of the settings
+// that change are rewritten, so the rest of the file's comments and formatting are kept. Secrets that
+// are still Redacted are left unchanged
+func Merge(data []byte, patch []byte) ([]byte, error) {
+	var p map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(patch))
+	d.UseNumber()
+	if err := d.Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid config patch: %w", err)
+	}
+	if err := unredact(p, "", false); err != nil {
+		return nil, err
+	}
+
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return encodeYAML(normalize(p))
+	}
+	root := doc.Content[0]
+	if root.Kind != yamlv3.MappingNode {
+		return nil, fmt.Errorf("config file is not a mapping of settings")
+	}
+	if root.Style&yamlv3.FlowStyle != 0 {
+		var old interface{}
+		if err := root.Decode(&old); err != nil {
+			return nil, err
+		}
+		return encodeYAML(mergePatch(old, p))
+	}
+
+	e := &editor{
+		lines: strings.Split(string(data), "\n"),
+	}
+	if err := e.mergeMapping(root, p, len(e.lines)); err != nil {
+		return nil, err
+	}
+
+	return []byte(e.String()), nil
+}
+
+// edit replaces the lines from start to end, numbered from 1. An edit with end before start
+// inserts its lines before start
+type edit struct {
+	start int
+	end   int
+	lines []string
+}
+
+// editor rewrites the lines of a YAML file
+type editor struct {
+	lines []string
+	edits []edit
+}
+
+// String returns the file with the edits made
+func (e *editor) String() string {
+	// Edits are made from the end of the file, so lines don't move before they're edited. Insertions
+	// at the end of a nested mapping and of its parent are at the same line, and the parent's,
+	// which was added last, must be made first to end up last
+	for i, j := 0, len(e.edits)-1; i < j; i, j = i+1, j-1 {
+		e.edits[i], e.edits[j] = e.edits[j], e.edits[i]
+	}
+	sort.SliceStable(e.edits, func(i, j int) bool {
+		return e.edits[i].start > e.edits[j].start
+	})
+
+	lines := e.lines
+	for _, ed := range e.edits {
+		updated := make([]string, 0, len(lines)+len(ed.lines))
+		updated = append(updated, lines[:ed.start-1]...)
+		updated = append(updated, ed.lines...)
+		updated = append(updated, lines[ed.end:]...)
+		lines = updated
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// entryEnd returns the last line of a mapping's i'th entry. The lines of a mapping's last entry end
+// at the end of the mapping. Trailing blank and comment lines belong to whatever comes next
+func (e *editor) entryEnd(node *yamlv3.Node, i int, end int) int {
+	if i+2 < len(node.Content) {
+		end = node.Content[i+2].Line - 1
+	}
+	for end > node.Content[i].Line {
+		line := strings.TrimSpace(e.lines[end-1])
+		if line != "" && !strings.HasPrefix(line, "#") {
+			break
+		}
+		end--
+	}
+
+	return end
+}
+
+// mergeMapping merges a patch into a block mapping, which ends at line end
+func (e *editor) mergeMapping(node *yamlv3.Node, patch map[string]interface{}, end int) error {
+	keys := make([]string, 0, len(patch))
+	for k := range patch {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var added []string
+	indent := node.Content[0].Column - 1
+
+	for _, key := range keys {
+		value := patch[key]
+		i := mappingIndex(node, key)
+
+		if i < 0 {
+			if value == nil {
+				continue
+			}
+			lines, err := encodeEntry(&yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key}, normalize(value), indent)
+			if err != nil {
+				return err
+			}
+			added = append(added, lines...)
+			continue
+		}
+
+		keyNode := node.Content[i]
+		old := node.Content[i+1]
+		entryEnd := e.entryEnd(node, i, end)
+
+		if value == nil {
+			start := keyNode.Line
+			if keyNode.HeadComment != "" {
+				// The comment above a setting goes with it
+				for start > 1 && strings.HasPrefix(strings.TrimSpace(e.lines[start-2]), "#") {
+					start--
+				}
+			}
+			e.edits = append(e.edits, edit{start: start, end: entryEnd})
+			continue
+		}
+
+		obj, isObj := value.(map[string]interface{})
+		if isObj && old.Kind == yamlv3.MappingNode && old.Style&yamlv3.FlowStyle == 0 {
+			if err := e.mergeMapping(old, obj, entryEnd); err != nil {
+				return err
+			}
+			continue
+		}
+
+		newValue := normalize(value)
+		if isObj && old.Kind == yamlv3.MappingNode {
+			var oldValue interface{}
+			if err := old.Decode(&oldValue); err != nil {
+				return err
+			}
+			newValue = mergePatch(oldValue, obj)
+		}
+
+		// Head comments are above the entry, and are kept as they are
+		k := *keyNode
+		k.HeadComment = ""
+		if k.LineComment == "" {
+			k.LineComment = old.LineComment
+		}
+		lines, err := encodeEntry(&k, newValue, keyNode.Column-1)
+		if err != nil {
+			return err
+		}
+		e.edits = append(e.edits, edit{start: keyNode.Line, end: entryEnd, lines: lines})
+	}
+
+	if len(added) > 0 {
+		last := e.entryEnd(node, len(node.Content)-2, end)
+		e.edits = append(e.edits, edit{start: last + 1, end: last, lines: added})
+	}
+
+	return nil
+}
+
+// encodeEntry encodes a mapping entry as YAML lines, indented by the given number of spaces
+func encodeEntry(key *yamlv3.Node, value interface{}, indent int) ([]string, error) {
+	var v yamlv3.Node
+	if err := v.Encode(value); err != nil {
+		return nil, fmt.Errorf("%s: %w", key.Value, err)
+	}
+	if v.Kind == yamlv3.ScalarNode {
+		v.LineComment = key.LineComment
+		k := *key
+		k.LineComment = ""
+		key = &k
+	}
+	data, err := encodeYAML(&yamlv3.Node{
+		Kind:    yamlv3.MappingNode,
+		Tag:     "!!map",
+		Content: []*yamlv3.Node{key, &v},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := strings.Repeat(" ", indent)
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	for i := range lines {
+		lines[i] = prefix + lines[i]
+	}
+
+	return lines, nil
+}
+
+func encodeYAML(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yamlv3.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// mergePatch applies a JSON merge patch to a decoded value
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return normalize(patch)
+	}
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = make(map[string]interface{})
+	}
+
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = mergePatch(t[k], v)
+	}
+
+	return t
+}
+
+// mappingIndex returns the index of a key in a mapping node, or -1. Like encoding/json, keys
+// are matched case insensitively if there is no exact match
+func mappingIndex(node *yamlv3.Node, key string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if strings.EqualFold(node.Content[i].Value, key) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// normalize drops nulls from objects, as a merge patch does for new settings, and converts
+// JSON numbers to ints where possible so they're written without a decimal point
+func normalize(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			if item != nil {
+				m[k] = normalize(item)
+			}
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(val))
+		for i, item := range val {
+			l[i] = normalize(item)
+		}
+		return l
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return i
+		}
+		f, _ := val.Float64()
+		return f
+	}
+
+	return v
+}
+
+// WriteFile atomically replaces a config file, keeping its permissions and owner
+func WriteFile(filename string, data []byte) error {
+	mode := os.FileMode(0o644)
+	info, err := os.Stat(filename)
+	if err == nil {
+		mode = info.Mode().Perm()
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(filename), "."+filepath.Base(filename)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), mode); err != nil {
+		return err
+	}
+	if info != nil {
+		if err := chownLike(tmp.Name(), info); err != nil {
+			return err
+		}
+	}
+
+	return os.Rename(tmp.Name(), filename)
+}
+
+// chownLike gives a file the same owner as another, when running as root
+func chownLike(filename string, info os.FileInfo) error {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok || os.Geteuid() != 0 {
+		return nil
+	}
+
+	return os.Chown(filename, int(stat.Uid), int(stat.Gid))
+}
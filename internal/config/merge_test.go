@@ -0,0 +1,178 @@
+package config
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/robbydyer/sports/pkg/databoard"
+	"github.com/robbydyer/sports/pkg/sportboard"
+	"github.com/robbydyer/sports/pkg/weatherboard"
+	"github.com/robbydyer/sports/pkg/webhook"
+)
+
+const mergeBase = `---
+# Hockey
+nhlConfig:
+  enabled: false
+  boardDelay: "10s"  # per game
+  watchTeams:
+  - ALL
+
+  # Favorites
+  favoriteTeams:
+  - NYI
+
+weatherConfig: {enabled: true, apiKey: secret}
+`
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name     string
+		patch    string
+		expected string
+	}{
+		{
+			name:     "empty",
+			patch:    `{}`,
+			expected: mergeBase,
+		},
+		{
+			name:  "replace",
+			patch: `{"nhlConfig": {"boardDelay": "15s", "watchTeams": ["BOS", "NYR"]}}`,
+			expected: `---
+# Hockey
+nhlConfig:
+  enabled: false
+  boardDelay: 15s # per game
+  watchTeams:
+    - BOS
+    - NYR
+
+  # Favorites
+  favoriteTeams:
+  - NYI
+
+weatherConfig: {enabled: true, apiKey: secret}
+`,
+		},
+		{
+			name:  "add and remove",
+			patch: `{"nhlConfig": {"favoriteTeams": null, "liveOnly": true}, "stocksConfig": {"symbols": ["AAPL"], "boardDelay": null}}`,
+			expected: `---
+# Hockey
+nhlConfig:
+  enabled: false
+  boardDelay: "10s"  # per game
+  watchTeams:
+  - ALL
+
+  liveOnly: true
+
+weatherConfig: {enabled: true, apiKey: secret}
+stocksConfig:
+  symbols:
+    - AAPL
+`,
+		},
+		{
+			name:  "flow mapping",
+			patch: `{"weatherConfig": {"zipCode": "02134", "apiKey": "` + Redacted + `"}}`,
+			expected: `---
+# Hockey
+nhlConfig:
+  enabled: false
+  boardDelay: "10s"  # per game
+  watchTeams:
+  - ALL
+
+  # Favorites
+  favoriteTeams:
+  - NYI
+
+weatherConfig:
+  apiKey: secret
+  enabled: true
+  zipCode: "02134"
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			merged, err := Merge([]byte(mergeBase), []byte(test.patch))
+			require.NoError(t, err)
+			require.Equal(t, test.expected, string(merged))
+		})
+	}
+}
+
+func TestMergeEmptyFile(t *testing.T) {
+	merged, err := Merge(nil, []byte(`{"clockConfig": {"enabled": true, "boardDelay": null}}`))
+	require.NoError(t, err)
+	require.Equal(t, "clockConfig:\n  enabled: true\n", string(merged))
+}
+
+func TestMergeRedactedList(t *testing.T) {
+	_, err := Merge([]byte(mergeBase), []byte(`{"sportsMatrixConfig": {"auth": {"tokens": [{"name": "ui", "token": "`+Redacted+`"}]}}}`))
+	require.EqualError(t, err, "sportsMatrixConfig.auth.tokens[0].token: secrets in a list must be sent in full")
+}
+
+func TestRedactedJSON(t *testing.T) {
+	c := &Config{
+		NHLConfig: &sportboard.Config{
+			Enabled:    atomic.NewBool(true),
+			WatchTeams: []string{"NYI"},
+		},
+		WeatherConfig: &weatherboard.Config{
+			APIKey: "secret",
+		},
+		Webhooks: []*webhook.Hook{
+			{URL: "https://example.com/hook?key=secret"},
+		},
+		DataBoards: []*databoard.Config{
+			{Name: "data", URL: "https://example.com/api?apikey=secret"},
+		},
+	}
+
+	data, err := RedactedJSON(c)
+	require.NoError(t, err)
+
+	var redacted *Config
+	require.NoError(t, json.Unmarshal(data, &redacted))
+	require.Equal(t, Redacted, redacted.WeatherConfig.APIKey)
+	require.Equal(t, Redacted, redacted.Webhooks[0].URL)
+	require.Equal(t, Redacted, redacted.DataBoards[0].URL)
+	require.Equal(t, "data", redacted.DataBoards[0].Name)
+	require.Equal(t, []string{"NYI"}, redacted.NHLConfig.WatchTeams)
+	require.True(t, redacted.NHLConfig.Enabled.Load())
+	require.Equal(t, "secret", c.WeatherConfig.APIKey)
+}
+
+func TestWriteFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fileName := filepath.Join(dir, "sportsmatrix.conf")
+	require.NoError(t, ioutil.WriteFile(fileName, []byte("old"), 0o600))
+
+	require.NoError(t, WriteFile(fileName, []byte("new")))
+
+	data, err := ioutil.ReadFile(fileName)
+	require.NoError(t, err)
+	require.Equal(t, "new", string(data))
+
+	info, err := os.Stat(fileName)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+}
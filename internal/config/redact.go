@@ -0,0 +1,89 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Redacted is shown in place of secrets, ie. API keys, in the effective config. Updates that send
+// it back leave the secret as it is
+const Redacted = "********"
+
+// secretKeys are the json names of settings that hold secrets. URLs are included, as APIs
+// often take their key in the query string
+var secretKeys = map[string]bool{
+	"apiKey":   true,
+	"password": true,
+	"token":    true,
+	"headers":  true,
+	"url":      true,
+}
+
+// RedactedJSON returns the config as JSON, with its secrets replaced by Redacted
+func RedactedJSON(c *Config) ([]byte, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(redact(v, false))
+}
+
+func redact(v interface{}, secret bool) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			val[k] = redact(item, secret || secretKeys[k])
+		}
+	case []interface{}:
+		for i, item := range val {
+			val[i] = redact(item, secret)
+		}
+	case string:
+		if secret && val != "" {
+			return Redacted
+		}
+	}
+
+	return v
+}
+
+// unredact drops settings from a patch that are still Redacted, so they're left unchanged. Lists
+// are replaced as a whole, so secrets in them must be sent in full
+func unredact(v interface{}, path string, inList bool) error {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			if item == Redacted {
+				if inList {
+					return fmt.Errorf("%s: secrets in a list must be sent in full", joinPath(path, k))
+				}
+				delete(val, k)
+				continue
+			}
+			if err := unredact(item, joinPath(path, k), inList); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for i, item := range val {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			if item == Redacted {
+				return fmt.Errorf("%s: secrets in a list must be sent in full", itemPath)
+			}
+			if err := unredact(item, itemPath, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+
+	"go.uber.org/atomic"
+)
+
+var atomicBoolType = reflect.TypeOf(&atomic.Bool{})
+
+// Diff returns the json path of every config field that differs between two configs,
+// ie. "nhlConfig.watchTeams"
+func Diff(old *Config, new *Config) []string {
+	return diffValues(reflect.ValueOf(old), reflect.ValueOf(new), "")
+}
+
+// ApplyToggles stores the new value of every changed *atomic.Bool field into the live
+// config. These are safe to change while boards are running. It returns the paths
+// that were applied
+func ApplyToggles(live *Config, new *Config, paths []string) []string {
+	var applied []string
+	for _, path := range paths {
+		liveVal, ok := lookup(reflect.ValueOf(live), path)
+		if !ok || liveVal.Type() != atomicBoolType || liveVal.IsNil() {
+			continue
+		}
+		newVal, ok := lookup(reflect.ValueOf(new), path)
+		if !ok || newVal.IsNil() {
+			continue
+		}
+
+		liveVal.Interface().(*atomic.Bool).Store(newVal.Interface().(*atomic.Bool).Load())
+		applied = append(applied, path)
+	}
+
+	return applied
+}
+
+// togglePaths returns the json path of every *atomic.Bool field in the config
+func togglePaths(c *Config) map[*atomic.Bool]string {
+	paths := make(map[*atomic.Bool]string)
+	collectToggles(reflect.ValueOf(c), "", paths)
+	return paths
+}
+
+func collectToggles(v reflect.Value, path string, paths map[*atomic.Bool]string) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		if v.Type() == atomicBoolType {
+			paths[v.Interface().(*atomic.Bool)] = path
+			return
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		name := jsonName(v.Type().Field(i))
+		if name == "" {
+			continue
+		}
+		fieldPath := name
+		if path != "" {
+			fieldPath = path + "." + name
+		}
+		collectToggles(v.Field(i), fieldPath, paths)
+	}
+}
+
+func diffValues(old reflect.Value, new reflect.Value, path string) []string {
+	if old.Kind() == reflect.Ptr || new.Kind() == reflect.Ptr {
+		if old.IsNil() && new.IsNil() {
+			return nil
+		}
+		if old.Type() == atomicBoolType {
+			if old.IsNil() || new.IsNil() || old.Interface().(*atomic.Bool).Load() != new.Interface().(*atomic.Bool).Load() {
+				return []string{path}
+			}
+			return nil
+		}
+		if old.IsNil() || new.IsNil() {
+			return []string{path}
+		}
+		old = old.Elem()
+		new = new.Elem()
+	}
+
+	if old.Kind() != reflect.Struct || !hasJSONFields(old.Type()) {
+		if reflect.DeepEqual(old.Interface(), new.Interface()) {
+			return nil
+		}
+		return []string{path}
+	}
+
+	var diffs []string
+	for i := 0; i < old.NumField(); i++ {
+		name := jsonName(old.Type().Field(i))
+		if name == "" {
+			continue
+		}
+		fieldPath := name
+		if path != "" {
+			fieldPath = path + "." + name
+		}
+		diffs = append(diffs, diffValues(old.Field(i), new.Field(i), fieldPath)...)
+	}
+
+	return diffs
+}
+
+func lookup(v reflect.Value, path string) (reflect.Value, bool) {
+FIELDS:
+	for _, name := range strings.Split(path, ".") {
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if jsonName(v.Type().Field(i)) == name {
+				v = v.Field(i)
+				continue FIELDS
+			}
+		}
+		return reflect.Value{}, false
+	}
+
+	return v, true
+}
+
+func jsonName(f reflect.StructField) string {
+	if f.PkgPath != "" {
+		return ""
+	}
+	tag := strings.Split(f.Tag.Get("json"), ",")[0]
+	if tag == "-" {
+		return ""
+	}
+
+	return tag
+}
+
+func hasJSONFields(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if jsonName(t.Field(i)) != "" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/robbydyer/sports/pkg/sportboard"
+	"github.com/robbydyer/sports/pkg/sportsmatrix"
+	"github.com/robbydyer/sports/pkg/weatherboard"
+)
+
+func TestDiff(t *testing.T) {
+	old := &Config{
+		NHLConfig: &sportboard.Config{
+			Enabled:    atomic.NewBool(true),
+			WatchTeams: []string{"NYI"},
+			BoardDelay: "20s",
+		},
+		SportsMatrixConfig: &sportsmatrix.Config{
+			HTTPListenPort: 8080,
+		},
+	}
+	new := &Config{
+		NHLConfig: &sportboard.Config{
+			Enabled:    atomic.NewBool(false),
+			WatchTeams: []string{"NYI", "PIT"},
+			BoardDelay: "20s",
+		},
+		SportsMatrixConfig: &sportsmatrix.Config{
+			HTTPListenPort: 80,
+		},
+		WeatherConfig: &weatherboard.Config{},
+	}
+
+	require.ElementsMatch(t,
+		[]string{
+			"nhlConfig.enabled",
+			"nhlConfig.watchTeams",
+			"sportsMatrixConfig.httpListenPort",
+			"weatherConfig",
+		},
+		Diff(old, new),
+	)
+	require.Empty(t, Diff(old, old))
+}
+
+func TestApplyToggles(t *testing.T) {
+	enabled := atomic.NewBool(true)
+	live := &Config{
+		NHLConfig: &sportboard.Config{
+			Enabled:    enabled,
+			WatchTeams: []string{"NYI"},
+		},
+	}
+	new := &Config{
+		NHLConfig: &sportboard.Config{
+			Enabled:    atomic.NewBool(false),
+			WatchTeams: []string{"PIT"},
+		},
+	}
+
+	applied := ApplyToggles(live, new, Diff(live, new))
+	require.Equal(t, []string{"nhlConfig.enabled"}, applied)
+	require.False(t, enabled.Load())
+	require.Equal(t, []string{"NYI"}, live.NHLConfig.WatchTeams)
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"reflect"
+
+	"go.uber.org/atomic"
+
+	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
+)
+
+// durationPattern matches the durations time.ParseDuration accepts, ie. 30s or 1h30m
+const durationPattern = `^([0-9.]+(ns|us|µs|ms|s|m|h))*$`
+
+var (
+	atomicBool   = reflect.TypeOf(atomic.Bool{})
+	atomicInt32  = reflect.TypeOf(atomic.Int32{})
+	atomicString = reflect.TypeOf(atomic.String{})
+)
+
+// Schema returns a JSON schema describing the config file, generated from the config structs
+// and their json tags
+func Schema() map[string]interface{} {
+	s := schemaFor(reflect.TypeOf(Config{}), make(map[reflect.Type]bool))
+	s["$schema"] = "http://json-schema.org/draft-07/schema#"
+	s["title"] = "sportsmatrix config"
+
+	return s
+}
+
+func schemaFor(t reflect.Type, visiting map[reflect.Type]bool) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t {
+	case atomicBool:
+		return map[string]interface{}{"type": "boolean"}
+	case atomicInt32:
+		return map[string]interface{}{"type": "integer"}
+	case atomicString:
+		return map[string]interface{}{"type": "string"}
+	}
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		// Decodes itself, so it could be anything
+		return map[string]interface{}{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{
+			"type":  "array",
+			"items": schemaFor(t.Elem(), visiting),
+		}
+	case reflect.Map:
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": schemaFor(t.Elem(), visiting),
+		}
+	case reflect.Struct:
+		if visiting[t] {
+			return map[string]interface{}{"type": "object"}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		props := make(map[string]interface{})
+		for name, f := range fieldsByName(t) {
+			if f.Type.Kind() == reflect.Interface {
+				continue
+			}
+			props[name] = fieldSchema(f, visiting)
+		}
+
+		return map[string]interface{}{
+			"type":                 "object",
+			"properties":           props,
+			"additionalProperties": false,
+		}
+	}
+
+	return map[string]interface{}{}
+}
+
+func fieldSchema(f reflect.StructField, visiting map[reflect.Type]bool) map[string]interface{} {
+	s := schemaFor(f.Type, visiting)
+
+	// Formats apply to each item of a list
+	value := s
+	if items, ok := s["items"].(map[string]interface{}); ok {
+		value = items
+	}
+
+	switch fieldFormat(f) {
+	case formatDuration:
+		value["pattern"] = durationPattern
+		value["description"] = "A duration, ie. 30s or 5m"
+	case formatCron:
+		value["description"] = "A cron schedule, ie. 0 7 * * *"
+	case formatHardwareMapping:
+		value["enum"] = append([]string{""}, rgb.HardwareMappings...)
+	}
+
+	return s
+}
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema(t *testing.T) {
+	data, err := json.Marshal(Schema())
+	require.NoError(t, err)
+
+	var schema struct {
+		Properties map[string]struct {
+			Type                 string                     `json:"type"`
+			AdditionalProperties bool                       `json:"additionalProperties"`
+			Properties           map[string]json.RawMessage `json:"properties"`
+		} `json:"properties"`
+	}
+	require.NoError(t, json.Unmarshal(data, &schema))
+
+	nhl := schema.Properties["nhlConfig"]
+	require.Equal(t, "object", nhl.Type)
+	require.False(t, nhl.AdditionalProperties)
+	require.JSONEq(t, `{"type": "boolean"}`, string(nhl.Properties["enabled"]))
+	require.JSONEq(t, `{"type": "array", "items": {"type": "string"}}`, string(nhl.Properties["watchTeams"]))
+	require.JSONEq(t, `{"type": "string", "pattern": "`+durationPattern+`", "description": "A duration, ie. 30s or 5m"}`,
+		string(nhl.Properties["boardDelay"]),
+	)
+	require.NotContains(t, nhl.Properties, "TodayFunc")
+
+	matrix := schema.Properties["sportsMatrixConfig"]
+	require.JSONEq(t, `{"type": "array", "items": {"type": "string", "description": "A cron schedule, ie. 0 7 * * *"}}`,
+		string(matrix.Properties["screenOnTimes"]),
+	)
+}
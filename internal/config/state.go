@@ -0,0 +1,163 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// StateStore persists runtime changes, such as a board being disabled via the API,
+// so that they survive a restart. Persisted values take precedence over the config file.
+// Config toggles are keyed by their json path, ie. "nhlConfig.hideFavoriteScore"
+type StateStore struct {
+	file  string
+	live  *Config
+	log   *zap.Logger
+	state map[string]bool
+	sync.Mutex
+}
+
+// NewStateStore loads the state file, if it exists
+func NewStateStore(filename string, live *Config, logger *zap.Logger) (*StateStore, error) {
+	s := &StateStore{
+		file:  filename,
+		live:  live,
+		log:   logger,
+		state: make(map[string]bool),
+	}
+
+	f, err := ioutil.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, s.save()
+		}
+		return nil, err
+	}
+
+	if len(f) > 0 {
+		if err := json.Unmarshal(f, &s.state); err != nil {
+			return nil, fmt.Errorf("failed to parse state file %s: %w", filename, err)
+		}
+	}
+
+	return s, nil
+}
+
+// Restore applies the persisted toggles to the live config. It returns the paths
+// that were restored
+func (s *StateStore) Restore() []string {
+	s.Lock()
+	defer s.Unlock()
+
+	var restored []string
+	for path, val := range s.state {
+		v, ok := lookup(reflect.ValueOf(s.live), path)
+		if !ok || v.Type() != atomicBoolType || v.IsNil() {
+			continue
+		}
+		v.Interface().(*atomic.Bool).Store(val)
+		restored = append(restored, path)
+	}
+	sort.Strings(restored)
+
+	return restored
+}
+
+// RecordToggles persists the current value of config toggles that were changed through the API.
+// Toggles that aren't part of the live config are ignored
+func (s *StateStore) RecordToggles(toggles ...*atomic.Bool) {
+	paths := togglePaths(s.live)
+
+	s.Lock()
+	defer s.Unlock()
+
+	changed := false
+	for _, t := range toggles {
+		path, ok := paths[t]
+		if !ok {
+			continue
+		}
+		s.state[path] = t.Load()
+		changed = true
+	}
+
+	if changed {
+		if err := s.save(); err != nil {
+			s.log.Error("failed to save state",
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// Store persists a value that isn't part of the config, such as the screen being off
+func (s *StateStore) Store(key string, val bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.state[key] = val
+	if err := s.save(); err != nil {
+		s.log.Error("failed to save state",
+			zap.Error(err),
+		)
+	}
+}
+
+// Load returns a persisted value and whether it was set
+func (s *StateStore) Load(key string) (bool, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	val, ok := s.state[key]
+	return val, ok
+}
+
+// Forget removes persisted values, such as when the config file sets them again
+func (s *StateStore) Forget(keys ...string) {
+	s.Lock()
+	defer s.Unlock()
+
+	changed := false
+	for _, key := range keys {
+		if _, ok := s.state[key]; ok {
+			delete(s.state, key)
+			changed = true
+		}
+	}
+
+	if changed {
+		if err := s.save(); err != nil {
+			s.log.Error("failed to save state",
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// Reset clears all persisted values. The current settings stay in effect until
+// the next restart, which will use only the config file
+func (s *StateStore) Reset() error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.state = make(map[string]bool)
+
+	return s.save()
+}
+
+func (s *StateStore) save() error {
+	b, err := json.MarshalIndent(s.state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write in place rather than replacing the file, so that it keeps its ownership
+	return ioutil.WriteFile(s.file, b, 0o644)
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/robbydyer/sports/pkg/sportboard"
+)
+
+func TestStateStore(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	file := filepath.Join(t.TempDir(), "state.json")
+
+	live := &Config{
+		NHLConfig: &sportboard.Config{
+			Enabled:           atomic.NewBool(true),
+			HideFavoriteScore: atomic.NewBool(false),
+		},
+	}
+
+	store, err := NewStateStore(file, live, logger)
+	require.NoError(t, err)
+
+	// Only recorded toggles are persisted, not every change to the live config
+	live.NHLConfig.HideFavoriteScore.Store(true)
+	live.NHLConfig.Enabled.Store(false)
+	store.RecordToggles(live.NHLConfig.HideFavoriteScore, atomic.NewBool(true))
+	store.Store("screenOn", false)
+
+	// A fresh config, as if the service restarted
+	restarted := &Config{
+		NHLConfig: &sportboard.Config{
+			Enabled:           atomic.NewBool(true),
+			HideFavoriteScore: atomic.NewBool(false),
+		},
+	}
+	store, err = NewStateStore(file, restarted, logger)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"nhlConfig.hideFavoriteScore"}, store.Restore())
+	require.True(t, restarted.NHLConfig.HideFavoriteScore.Load())
+	require.True(t, restarted.NHLConfig.Enabled.Load())
+
+	on, ok := store.Load("screenOn")
+	require.True(t, ok)
+	require.False(t, on)
+
+	require.NoError(t, store.Reset())
+
+	store, err = NewStateStore(file, restarted, logger)
+	require.NoError(t, err)
+	require.Empty(t, store.Restore())
+	_, ok = store.Load("screenOn")
+	require.False(t, ok)
+}
@@ -0,0 +1,373 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/robfig/cron/v3"
+	yamlv3 "gopkg.in/yaml.v3"
+
+	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
+)
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// Problem is an error in a config file
+type Problem struct {
+	// Line is where the problem is in the file, or 0 if it isn't known
+	Line    int
+	Path    string
+	Message string
+}
+
+// Validation is the result of validating a config file
+type Validation struct {
+	// Config is the decoded config, if the file could be decoded
+	Config   *Config
+	Problems []*Problem
+	// lines are the line numbers of every key and list item in the file, by json path
+	lines map[string]int
+}
+
+// String ...
+func (p *Problem) String() string {
+	if p.Line > 0 {
+		return fmt.Sprintf("line %d: %s: %s", p.Line, p.Path, p.Message)
+	}
+	return fmt.Sprintf("%s: %s", p.Path, p.Message)
+}
+
+// Validate strictly checks a YAML config file against the Config struct. It reports keys that don't match
+// any field, values of the wrong type, durations and cron schedules that don't parse, and unknown hardware
+// mappings. An error is only returned if the file isn't valid YAML
+func Validate(data []byte) (*Validation, error) {
+	v := &Validation{
+		lines: make(map[string]int),
+	}
+
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) > 0 {
+		v.walk(doc.Content[0], reflect.TypeOf(Config{}), "")
+	}
+
+	// Unknown keys were already found with their line numbers, so only type errors are left
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	var c *Config
+	if err := json.Unmarshal(jsonData, &c); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			path := fieldPath(typeErr.Field)
+			if !v.hasProblem(path) {
+				v.Add(path, "expected %s, got %s", typeErr.Type, typeErr.Value)
+			}
+		} else {
+			v.Add("", "%s", err)
+		}
+	} else {
+		if c == nil {
+			c = &Config{}
+		}
+		v.Config = c
+	}
+
+	return v, nil
+}
+
+// Add adds a problem with the value at the path, ie. nhlConfig.watchTeams[0]
+func (v *Validation) Add(path string, format string, a ...interface{}) {
+	v.Problems = append(v.Problems, &Problem{
+		Line:    v.lines[path],
+		Path:    path,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+// hasProblem returns true if a problem was already found with the value at the path
+func (v *Validation) hasProblem(path string) bool {
+	for _, p := range v.Problems {
+		if p.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+// Sorted returns the problems in the order they appear in the file
+func (v *Validation) Sorted() []*Problem {
+	sorted := make([]*Problem, len(v.Problems))
+	copy(sorted, v.Problems)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Line < sorted[j].Line
+	})
+
+	return sorted
+}
+
+func (v *Validation) walk(node *yamlv3.Node, t reflect.Type, path string) {
+	if node.Kind == yamlv3.AliasNode {
+		node = node.Alias
+	}
+	for t.Kind() == reflect.Ptr {
+		if t.Implements(unmarshalerType) {
+			return
+		}
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if node.Kind != yamlv3.MappingNode {
+			return
+		}
+		fields := fieldsByName(t)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			keyPath := joinPath(path, key.Value)
+			v.lines[keyPath] = key.Line
+
+			f, ok := fields[key.Value]
+			if !ok {
+				// Like encoding/json, keys match fields case insensitively
+				for name, field := range fields {
+					if strings.EqualFold(name, key.Value) {
+						f, ok = field, true
+						break
+					}
+				}
+			}
+			if !ok {
+				v.unknownKey(keyPath, key.Value, fields)
+				continue
+			}
+
+			v.checkValue(node.Content[i+1], f, keyPath)
+			v.walk(node.Content[i+1], f.Type, keyPath)
+		}
+	case reflect.Map:
+		if node.Kind != yamlv3.MappingNode {
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyPath := joinPath(path, node.Content[i].Value)
+			v.lines[keyPath] = node.Content[i].Line
+			v.walk(node.Content[i+1], t.Elem(), keyPath)
+		}
+	case reflect.Slice, reflect.Array:
+		if node.Kind != yamlv3.SequenceNode {
+			return
+		}
+		for i, item := range node.Content {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			v.lines[itemPath] = item.Line
+			v.walk(item, t.Elem(), itemPath)
+		}
+	}
+}
+
+func (v *Validation) unknownKey(path string, key string, fields map[string]reflect.StructField) {
+	closest := ""
+	best := 3
+	for name := range fields {
+		if d := distance(strings.ToLower(key), strings.ToLower(name)); d < best {
+			closest, best = name, d
+		}
+	}
+
+	if closest != "" {
+		v.Add(path, "unknown key %q, did you mean %q?", key, closest)
+		return
+	}
+	v.Add(path, "unknown key %q", key)
+}
+
+const (
+	formatDuration        = "duration"
+	formatCron            = "cron"
+	formatHardwareMapping = "hardwareMapping"
+)
+
+// fieldFormat returns the format of a string, or list of strings, field that is parsed later, when
+// boards are created. Fields are recognized by name: durations, ie. boardDelay or updateInterval, cron
+// schedules, ie. onTimes, and the hardware mapping
+func fieldFormat(f reflect.StructField) string {
+	name := strings.ToLower(fieldName(f))
+	kind := f.Type.Kind()
+
+	switch {
+	case kind == reflect.String && isDurationName(name):
+		return formatDuration
+	case kind == reflect.String && name == "time":
+		return formatCron
+	case kind == reflect.Slice && f.Type.Elem().Kind() == reflect.String && strings.HasSuffix(name, "times"):
+		return formatCron
+	case kind == reflect.String && name == "hardwaremapping":
+		return formatHardwareMapping
+	}
+
+	return ""
+}
+
+// checkValue checks the values of fields with a format
+func (v *Validation) checkValue(node *yamlv3.Node, f reflect.StructField, path string) {
+	switch fieldFormat(f) {
+	case formatDuration:
+		if node.Kind == yamlv3.ScalarNode && node.Value != "" {
+			if _, err := time.ParseDuration(node.Value); err != nil {
+				v.Add(path, "invalid duration %q, ie. 30s or 5m", node.Value)
+			}
+		}
+	case formatCron:
+		if node.Kind != yamlv3.SequenceNode {
+			v.checkCron(node, path)
+			return
+		}
+		for i, item := range node.Content {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			v.lines[itemPath] = item.Line
+			v.checkCron(item, itemPath)
+		}
+	case formatHardwareMapping:
+		if node.Kind == yamlv3.ScalarNode && node.Value != "" && !contains(rgb.HardwareMappings, node.Value) {
+			v.Add(path, "unknown hardware mapping %q, choose from %s", node.Value, strings.Join(rgb.HardwareMappings, ", "))
+		}
+	}
+}
+
+func (v *Validation) checkCron(node *yamlv3.Node, path string) {
+	if node.Kind != yamlv3.ScalarNode || node.Value == "" {
+		return
+	}
+	if _, err := cron.ParseStandard(node.Value); err != nil {
+		v.Add(path, "invalid cron schedule %q: %s", node.Value, err)
+	}
+}
+
+func isDurationName(name string) bool {
+	if name == "dwell" {
+		return true
+	}
+	for _, suffix := range []string{"delay", "interval", "timeout", "cooldown", "duration"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fieldsByName returns a struct's fields as they're named in the config, including the fields of
+// embedded structs
+func fieldsByName(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Tag.Get("json") == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for name, embedded := range fieldsByName(ft) {
+					if _, ok := fields[name]; !ok {
+						fields[name] = embedded
+					}
+				}
+				continue
+			}
+		}
+		if name := fieldName(f); name != "" {
+			fields[name] = f
+		}
+	}
+
+	return fields
+}
+
+// fieldName is the name of a field in the config. Exported fields without a json tag use the field name
+func fieldName(f reflect.StructField) string {
+	if f.PkgPath != "" || f.Type.Kind() == reflect.Func || f.Type.Kind() == reflect.Chan {
+		return ""
+	}
+	if f.Tag.Get("json") == "" {
+		return f.Name
+	}
+
+	return jsonName(f)
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// fieldPath converts the field of a json.UnmarshalTypeError, ie. plugins.0.boardDelay, to a path
+func fieldPath(field string) string {
+	var path string
+	for _, name := range strings.Split(field, ".") {
+		if _, err := strconv.Atoi(name); err == nil {
+			path += "[" + name + "]"
+			continue
+		}
+		path = joinPath(path, name)
+	}
+	return path
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+// distance is the Levenshtein distance between two strings
+func distance(a string, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(b)]
+}
+
+func min(vals ...int) int {
+	m := vals[0]
+	for _, v := range vals[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
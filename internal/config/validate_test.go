@@ -0,0 +1,77 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	data := []byte(`
+nhlConfig:
+  enabled: true
+  boardDelay: 20x
+  favoritTeams:
+  - NYI
+sportsMatrixConfig:
+  hardwareConfig:
+    hardwareMapping: adafruit
+    rows: abc
+  screenOnTimes:
+  - "0 7 * * *"
+  - "99 * * * *"
+clockConfig:
+  enabled: true
+  foo: bar
+`)
+
+	v, err := Validate(data)
+	require.NoError(t, err)
+
+	var problems []string
+	for _, p := range v.Sorted() {
+		problems = append(problems, p.String())
+	}
+
+	require.Equal(t, []string{
+		`line 4: nhlConfig.boardDelay: invalid duration "20x", ie. 30s or 5m`,
+		`line 5: nhlConfig.favoritTeams: unknown key "favoritTeams", did you mean "favoriteTeams"?`,
+		`line 9: sportsMatrixConfig.hardwareConfig.hardwareMapping: unknown hardware mapping "adafruit", choose from regular, adafruit-hat, adafruit-hat-pwm, regular-pi1, classic, classic-pi1, compute-module`,
+		`line 10: sportsMatrixConfig.hardwareConfig.rows: expected int, got string`,
+		`line 13: sportsMatrixConfig.screenOnTimes[1]: invalid cron schedule "99 * * * *": end of range (99) above maximum (59): 99`,
+		`line 16: clockConfig.foo: unknown key "foo"`,
+	}, problems)
+}
+
+func TestValidateValid(t *testing.T) {
+	data := []byte(`
+nhlConfig:
+  enabled: true
+  boardDelay: 20s
+  watchTeams:
+  - NYI
+sportsMatrixConfig:
+  hardwareConfig:
+    hardwareMapping: adafruit-hat
+  screenOnTimes:
+  - "0 7 * * *"
+`)
+
+	v, err := Validate(data)
+	require.NoError(t, err)
+	require.Empty(t, v.Problems)
+	require.Equal(t, "20s", v.Config.NHLConfig.BoardDelay)
+}
+
+func TestValidateListType(t *testing.T) {
+	data := []byte(`
+plugins:
+- name: demo
+  command: /usr/local/bin/demo
+`)
+
+	v, err := Validate(data)
+	require.NoError(t, err)
+	require.Len(t, v.Problems, 1)
+	require.Equal(t, `line 4: plugins[0].command: expected []string, got string`, v.Problems[0].String())
+}
@@ -73,9 +73,11 @@ type Status struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ScreenOn       bool `protobuf:"varint,1,opt,name=screen_on,json=screenOn,proto3" json:"screen_on,omitempty"`
-	WebboardOn     bool `protobuf:"varint,2,opt,name=webboard_on,json=webboardOn,proto3" json:"webboard_on,omitempty"`
-	CombinedScroll bool `protobuf:"varint,3,opt,name=combined_scroll,json=combinedScroll,proto3" json:"combined_scroll,omitempty"`
+	ScreenOn           bool    `protobuf:"varint,1,opt,name=screen_on,json=screenOn,proto3" json:"screen_on,omitempty"`
+	WebboardOn         bool    `protobuf:"varint,2,opt,name=webboard_on,json=webboardOn,proto3" json:"webboard_on,omitempty"`
+	CombinedScroll     bool    `protobuf:"varint,3,opt,name=combined_scroll,json=combinedScroll,proto3" json:"combined_scroll,omitempty"`
+	EstimatedMilliamps float64 `protobuf:"fixed64,4,opt,name=estimated_milliamps,json=estimatedMilliamps,proto3" json:"estimated_milliamps,omitempty"`
+	PowerLimited       bool    `protobuf:"varint,5,opt,name=power_limited,json=powerLimited,proto3" json:"power_limited,omitempty"`
 }
 
 func (x *Status) Reset() {
@@ -131,31 +133,603 @@ func (x *Status) GetCombinedScroll() bool {
 	return false
 }
 
+func (x *Status) GetEstimatedMilliamps() float64 {
+	if x != nil {
+		return x.EstimatedMilliamps
+	}
+	return 0
+}
+
+func (x *Status) GetPowerLimited() bool {
+	if x != nil {
+		return x.PowerLimited
+	}
+	return false
+}
+
 type SetAllReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *SetAllReq) Reset() {
+	*x = SetAllReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAllReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAllReq) ProtoMessage() {}
+
+func (x *SetAllReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAllReq.ProtoReflect.Descriptor instead.
+func (*SetAllReq) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetAllReq) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type JumpReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Board string `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+}
+
+func (x *JumpReq) Reset() {
+	*x = JumpReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JumpReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JumpReq) ProtoMessage() {}
+
+func (x *JumpReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JumpReq.ProtoReflect.Descriptor instead.
+func (*JumpReq) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JumpReq) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+type LiveOnlyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LiveOnly bool `protobuf:"varint,1,opt,name=live_only,json=liveOnly,proto3" json:"live_only,omitempty"`
+}
+
+func (x *LiveOnlyReq) Reset() {
+	*x = LiveOnlyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiveOnlyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveOnlyReq) ProtoMessage() {}
+
+func (x *LiveOnlyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveOnlyReq.ProtoReflect.Descriptor instead.
+func (*LiveOnlyReq) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LiveOnlyReq) GetLiveOnly() bool {
+	if x != nil {
+		return x.LiveOnly
+	}
+	return false
+}
+
+type PlaylistReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PlaylistReq) Reset() {
+	*x = PlaylistReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaylistReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistReq) ProtoMessage() {}
+
+func (x *PlaylistReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistReq.ProtoReflect.Descriptor instead.
+func (*PlaylistReq) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PlaylistReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PlaylistsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names  []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	Active string   `protobuf:"bytes,2,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *PlaylistsResp) Reset() {
+	*x = PlaylistsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaylistsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistsResp) ProtoMessage() {}
+
+func (x *PlaylistsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistsResp.ProtoReflect.Descriptor instead.
+func (*PlaylistsResp) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PlaylistsResp) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *PlaylistsResp) GetActive() string {
+	if x != nil {
+		return x.Active
+	}
+	return ""
+}
+
+type ReloadConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Applied         []string `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	RestartRequired []string `protobuf:"bytes,2,rep,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+	Errors          []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ReloadConfigResp) Reset() {
+	*x = ReloadConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigResp) ProtoMessage() {}
+
+func (x *ReloadConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigResp.ProtoReflect.Descriptor instead.
+func (*ReloadConfigResp) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReloadConfigResp) GetApplied() []string {
+	if x != nil {
+		return x.Applied
+	}
+	return nil
+}
+
+func (x *ReloadConfigResp) GetRestartRequired() []string {
+	if x != nil {
+		return x.RestartRequired
+	}
+	return nil
+}
+
+func (x *ReloadConfigResp) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type RecordReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seconds int32 `protobuf:"varint,1,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	Scale   int32 `protobuf:"varint,2,opt,name=scale,proto3" json:"scale,omitempty"`
+}
+
+func (x *RecordReq) Reset() {
+	*x = RecordReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordReq) ProtoMessage() {}
+
+func (x *RecordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordReq.ProtoReflect.Descriptor instead.
+func (*RecordReq) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RecordReq) GetSeconds() int32 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *RecordReq) GetScale() int32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+type RecordResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+}
+
+func (x *RecordResp) Reset() {
+	*x = RecordResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordResp) ProtoMessage() {}
+
+func (x *RecordResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordResp.ProtoReflect.Descriptor instead.
+func (*RecordResp) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RecordResp) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+type BoardHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled        bool    `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	LastRender     string  `protobuf:"bytes,3,opt,name=last_render,json=lastRender,proto3" json:"last_render,omitempty"`
+	LastError      string  `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorTime  string  `protobuf:"bytes,5,opt,name=last_error_time,json=lastErrorTime,proto3" json:"last_error_time,omitempty"`
+	DataUpdated    string  `protobuf:"bytes,6,opt,name=data_updated,json=dataUpdated,proto3" json:"data_updated,omitempty"`
+	DataAgeSeconds float64 `protobuf:"fixed64,7,opt,name=data_age_seconds,json=dataAgeSeconds,proto3" json:"data_age_seconds,omitempty"`
+	SkipReason     string  `protobuf:"bytes,8,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+}
+
+func (x *BoardHealth) Reset() {
+	*x = BoardHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardHealth) ProtoMessage() {}
+
+func (x *BoardHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardHealth.ProtoReflect.Descriptor instead.
+func (*BoardHealth) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BoardHealth) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardHealth) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *BoardHealth) GetLastRender() string {
+	if x != nil {
+		return x.LastRender
+	}
+	return ""
+}
+
+func (x *BoardHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *BoardHealth) GetLastErrorTime() string {
+	if x != nil {
+		return x.LastErrorTime
+	}
+	return ""
+}
+
+func (x *BoardHealth) GetDataUpdated() string {
+	if x != nil {
+		return x.DataUpdated
+	}
+	return ""
+}
+
+func (x *BoardHealth) GetDataAgeSeconds() float64 {
+	if x != nil {
+		return x.DataAgeSeconds
+	}
+	return 0
+}
+
+func (x *BoardHealth) GetSkipReason() string {
+	if x != nil {
+		return x.SkipReason
+	}
+	return ""
+}
+
+type HealthResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Boards []*BoardHealth `protobuf:"bytes,1,rep,name=boards,proto3" json:"boards,omitempty"`
+}
+
+func (x *HealthResp) Reset() {
+	*x = HealthResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthResp) ProtoMessage() {}
+
+func (x *HealthResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthResp.ProtoReflect.Descriptor instead.
+func (*HealthResp) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HealthResp) GetBoards() []*BoardHealth {
+	if x != nil {
+		return x.Boards
+	}
+	return nil
+}
+
+type BrightnessReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brightness int32 `protobuf:"varint,1,opt,name=brightness,proto3" json:"brightness,omitempty"`
 }
 
-func (x *SetAllReq) Reset() {
-	*x = SetAllReq{}
+func (x *BrightnessReq) Reset() {
+	*x = BrightnessReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[2]
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetAllReq) String() string {
+func (x *BrightnessReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetAllReq) ProtoMessage() {}
+func (*BrightnessReq) ProtoMessage() {}
 
-func (x *SetAllReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[2]
+func (x *BrightnessReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,43 +740,43 @@ func (x *SetAllReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetAllReq.ProtoReflect.Descriptor instead.
-func (*SetAllReq) Descriptor() ([]byte, []int) {
-	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use BrightnessReq.ProtoReflect.Descriptor instead.
+func (*BrightnessReq) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SetAllReq) GetEnabled() bool {
+func (x *BrightnessReq) GetBrightness() int32 {
 	if x != nil {
-		return x.Enabled
+		return x.Brightness
 	}
-	return false
+	return 0
 }
 
-type JumpReq struct {
+type BrightnessResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Board string `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Brightness int32 `protobuf:"varint,1,opt,name=brightness,proto3" json:"brightness,omitempty"`
 }
 
-func (x *JumpReq) Reset() {
-	*x = JumpReq{}
+func (x *BrightnessResp) Reset() {
+	*x = BrightnessResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[3]
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JumpReq) String() string {
+func (x *BrightnessResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JumpReq) ProtoMessage() {}
+func (*BrightnessResp) ProtoMessage() {}
 
-func (x *JumpReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[3]
+func (x *BrightnessResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -213,43 +787,92 @@ func (x *JumpReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JumpReq.ProtoReflect.Descriptor instead.
-func (*JumpReq) Descriptor() ([]byte, []int) {
-	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use BrightnessResp.ProtoReflect.Descriptor instead.
+func (*BrightnessResp) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *JumpReq) GetBoard() string {
+func (x *BrightnessResp) GetBrightness() int32 {
 	if x != nil {
-		return x.Board
+		return x.Brightness
+	}
+	return 0
+}
+
+// ConfigResp is the effective config of every board, as JSON
+type ConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config string `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ConfigResp) Reset() {
+	*x = ConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigResp) ProtoMessage() {}
+
+func (x *ConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigResp.ProtoReflect.Descriptor instead.
+func (*ConfigResp) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ConfigResp) GetConfig() string {
+	if x != nil {
+		return x.Config
 	}
 	return ""
 }
 
-type LiveOnlyReq struct {
+// ConfigSchemaResp is a JSON schema describing the config
+type ConfigSchemaResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LiveOnly bool `protobuf:"varint,1,opt,name=live_only,json=liveOnly,proto3" json:"live_only,omitempty"`
+	Schema string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
-func (x *LiveOnlyReq) Reset() {
-	*x = LiveOnlyReq{}
+func (x *ConfigSchemaResp) Reset() {
+	*x = ConfigSchemaResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[4]
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LiveOnlyReq) String() string {
+func (x *ConfigSchemaResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LiveOnlyReq) ProtoMessage() {}
+func (*ConfigSchemaResp) ProtoMessage() {}
 
-func (x *LiveOnlyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[4]
+func (x *ConfigSchemaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -260,16 +883,65 @@ func (x *LiveOnlyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LiveOnlyReq.ProtoReflect.Descriptor instead.
-func (*LiveOnlyReq) Descriptor() ([]byte, []int) {
-	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ConfigSchemaResp.ProtoReflect.Descriptor instead.
+func (*ConfigSchemaResp) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *LiveOnlyReq) GetLiveOnly() bool {
+func (x *ConfigSchemaResp) GetSchema() string {
 	if x != nil {
-		return x.LiveOnly
+		return x.Schema
 	}
-	return false
+	return ""
+}
+
+// UpdateConfigReq is a partial config, as JSON, that is merged into the config file
+// as a JSON merge patch. Null values remove a setting
+type UpdateConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config string `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *UpdateConfigReq) Reset() {
+	*x = UpdateConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConfigReq) ProtoMessage() {}
+
+func (x *UpdateConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sportsmatrix_sportsmatrix_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConfigReq.ProtoReflect.Descriptor instead.
+func (*UpdateConfigReq) Descriptor() ([]byte, []int) {
+	return file_sportsmatrix_sportsmatrix_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateConfigReq) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
 }
 
 var File_sportsmatrix_sportsmatrix_proto protoreflect.FileDescriptor
@@ -282,63 +954,172 @@ var file_sportsmatrix_sportsmatrix_proto_rawDesc = []byte{
 	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x27, 0x0a, 0x0b, 0x56, 0x65, 0x72,
 	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
 	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09,
-	0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x62,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x77, 0x65, 0x62, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
-	0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x63, 0x72,
-	0x6f, 0x6c, 0x6c, 0x22, 0x25, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71,
-	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x1f, 0x0a, 0x07, 0x4a, 0x75,
-	0x6d, 0x70, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x22, 0x2a, 0x0a, 0x0b, 0x4c,
-	0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69,
-	0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c,
-	0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x32, 0xdc, 0x04, 0x0a, 0x0c, 0x53, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x39, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x6d, 0x61,
-	0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x08, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x6e, 0x12,
+	0x6f, 0x6e, 0x22, 0xc5, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65,
+	0x62, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x77, 0x65, 0x62, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x63,
+	0x72, 0x6f, 0x6c, 0x6c, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x12, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6c, 0x6c,
+	0x69, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x22, 0x25, 0x0a, 0x09, 0x53, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x22, 0x1f, 0x0a, 0x07, 0x4a, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x22, 0x2a, 0x0a, 0x0b, 0x4c, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65,
+	0x71, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x21,
+	0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x3d, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x22, 0x6f, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x22, 0x3b, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x20,
+	0x0a, 0x0a, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x22, 0x91, 0x02, 0x0a, 0x0b, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26,
+	0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61,
+	0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x0a, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x2e, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x73, 0x22, 0x2f, 0x0a, 0x0d, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x22, 0x30, 0x0a, 0x0e, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x67, 0x68,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x24, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x2a, 0x0a, 0x10, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x29, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x32, 0x9b, 0x09, 0x0a, 0x0c, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x6d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x12, 0x39, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a,
+	0x0a, 0x08, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x53, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x6d,
+	0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x36, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x2e, 0x6d,
+	0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x3b, 0x0a, 0x09, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x09,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x32, 0x0a, 0x04, 0x4a, 0x75, 0x6d, 0x70, 0x12, 0x12, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x40, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c,
+	0x79, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x06,
-	0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x04, 0x4a, 0x75, 0x6d, 0x70, 0x12, 0x12, 0x2e, 0x6d,
-	0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x4e, 0x65, 0x78, 0x74,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x16, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x40, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x43, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x69,
-	0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x62, 0x62, 0x79, 0x64, 0x79, 0x65, 0x72, 0x2f, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x6d, 0x61, 0x74, 0x72, 0x69,
-	0x78, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x14, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0d,
+	0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a,
+	0x09, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x15, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0d, 0x53, 0x65, 0x74,
+	0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x32, 0xd5, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a, 0x0a, 0x09, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x15, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x47, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1a, 0x2e, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6d, 0x61,
+	0x74, 0x72, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x62, 0x62, 0x79, 0x64, 0x79, 0x65, 0x72,
+	0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x6d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -353,41 +1134,78 @@ func file_sportsmatrix_sportsmatrix_proto_rawDescGZIP() []byte {
 	return file_sportsmatrix_sportsmatrix_proto_rawDescData
 }
 
-var file_sportsmatrix_sportsmatrix_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_sportsmatrix_sportsmatrix_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_sportsmatrix_sportsmatrix_proto_goTypes = []interface{}{
-	(*VersionResp)(nil), // 0: matrix.v1.VersionResp
-	(*Status)(nil),      // 1: matrix.v1.Status
-	(*SetAllReq)(nil),   // 2: matrix.v1.SetAllReq
-	(*JumpReq)(nil),     // 3: matrix.v1.JumpReq
-	(*LiveOnlyReq)(nil), // 4: matrix.v1.LiveOnlyReq
-	(*empty.Empty)(nil), // 5: google.protobuf.Empty
+	(*VersionResp)(nil),      // 0: matrix.v1.VersionResp
+	(*Status)(nil),           // 1: matrix.v1.Status
+	(*SetAllReq)(nil),        // 2: matrix.v1.SetAllReq
+	(*JumpReq)(nil),          // 3: matrix.v1.JumpReq
+	(*LiveOnlyReq)(nil),      // 4: matrix.v1.LiveOnlyReq
+	(*PlaylistReq)(nil),      // 5: matrix.v1.PlaylistReq
+	(*PlaylistsResp)(nil),    // 6: matrix.v1.PlaylistsResp
+	(*ReloadConfigResp)(nil), // 7: matrix.v1.ReloadConfigResp
+	(*RecordReq)(nil),        // 8: matrix.v1.RecordReq
+	(*RecordResp)(nil),       // 9: matrix.v1.RecordResp
+	(*BoardHealth)(nil),      // 10: matrix.v1.BoardHealth
+	(*HealthResp)(nil),       // 11: matrix.v1.HealthResp
+	(*BrightnessReq)(nil),    // 12: matrix.v1.BrightnessReq
+	(*BrightnessResp)(nil),   // 13: matrix.v1.BrightnessResp
+	(*ConfigResp)(nil),       // 14: matrix.v1.ConfigResp
+	(*ConfigSchemaResp)(nil), // 15: matrix.v1.ConfigSchemaResp
+	(*UpdateConfigReq)(nil),  // 16: matrix.v1.UpdateConfigReq
+	(*empty.Empty)(nil),      // 17: google.protobuf.Empty
 }
 var file_sportsmatrix_sportsmatrix_proto_depIdxs = []int32{
-	5,  // 0: matrix.v1.Sportsmatrix.Version:input_type -> google.protobuf.Empty
-	5,  // 1: matrix.v1.Sportsmatrix.ScreenOn:input_type -> google.protobuf.Empty
-	5,  // 2: matrix.v1.Sportsmatrix.ScreenOff:input_type -> google.protobuf.Empty
-	5,  // 3: matrix.v1.Sportsmatrix.GetStatus:input_type -> google.protobuf.Empty
-	1,  // 4: matrix.v1.Sportsmatrix.SetStatus:input_type -> matrix.v1.Status
-	2,  // 5: matrix.v1.Sportsmatrix.SetAll:input_type -> matrix.v1.SetAllReq
-	3,  // 6: matrix.v1.Sportsmatrix.Jump:input_type -> matrix.v1.JumpReq
-	5,  // 7: matrix.v1.Sportsmatrix.NextBoard:input_type -> google.protobuf.Empty
-	5,  // 8: matrix.v1.Sportsmatrix.RestartService:input_type -> google.protobuf.Empty
-	4,  // 9: matrix.v1.Sportsmatrix.SetLiveOnly:input_type -> matrix.v1.LiveOnlyReq
-	0,  // 10: matrix.v1.Sportsmatrix.Version:output_type -> matrix.v1.VersionResp
-	5,  // 11: matrix.v1.Sportsmatrix.ScreenOn:output_type -> google.protobuf.Empty
-	5,  // 12: matrix.v1.Sportsmatrix.ScreenOff:output_type -> google.protobuf.Empty
-	1,  // 13: matrix.v1.Sportsmatrix.GetStatus:output_type -> matrix.v1.Status
-	5,  // 14: matrix.v1.Sportsmatrix.SetStatus:output_type -> google.protobuf.Empty
-	5,  // 15: matrix.v1.Sportsmatrix.SetAll:output_type -> google.protobuf.Empty
-	5,  // 16: matrix.v1.Sportsmatrix.Jump:output_type -> google.protobuf.Empty
-	5,  // 17: matrix.v1.Sportsmatrix.NextBoard:output_type -> google.protobuf.Empty
-	5,  // 18: matrix.v1.Sportsmatrix.RestartService:output_type -> google.protobuf.Empty
-	5,  // 19: matrix.v1.Sportsmatrix.SetLiveOnly:output_type -> google.protobuf.Empty
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	10, // 0: matrix.v1.HealthResp.boards:type_name -> matrix.v1.BoardHealth
+	17, // 1: matrix.v1.Sportsmatrix.Version:input_type -> google.protobuf.Empty
+	17, // 2: matrix.v1.Sportsmatrix.ScreenOn:input_type -> google.protobuf.Empty
+	17, // 3: matrix.v1.Sportsmatrix.ScreenOff:input_type -> google.protobuf.Empty
+	17, // 4: matrix.v1.Sportsmatrix.GetStatus:input_type -> google.protobuf.Empty
+	1,  // 5: matrix.v1.Sportsmatrix.SetStatus:input_type -> matrix.v1.Status
+	2,  // 6: matrix.v1.Sportsmatrix.SetAll:input_type -> matrix.v1.SetAllReq
+	3,  // 7: matrix.v1.Sportsmatrix.Jump:input_type -> matrix.v1.JumpReq
+	17, // 8: matrix.v1.Sportsmatrix.NextBoard:input_type -> google.protobuf.Empty
+	17, // 9: matrix.v1.Sportsmatrix.RestartService:input_type -> google.protobuf.Empty
+	4,  // 10: matrix.v1.Sportsmatrix.SetLiveOnly:input_type -> matrix.v1.LiveOnlyReq
+	5,  // 11: matrix.v1.Sportsmatrix.SetPlaylist:input_type -> matrix.v1.PlaylistReq
+	17, // 12: matrix.v1.Sportsmatrix.GetPlaylists:input_type -> google.protobuf.Empty
+	17, // 13: matrix.v1.Sportsmatrix.ReloadConfig:input_type -> google.protobuf.Empty
+	17, // 14: matrix.v1.Sportsmatrix.ResetState:input_type -> google.protobuf.Empty
+	8,  // 15: matrix.v1.Sportsmatrix.Record:input_type -> matrix.v1.RecordReq
+	17, // 16: matrix.v1.Sportsmatrix.StopRecording:input_type -> google.protobuf.Empty
+	17, // 17: matrix.v1.Sportsmatrix.GetHealth:input_type -> google.protobuf.Empty
+	12, // 18: matrix.v1.Sportsmatrix.SetBrightness:input_type -> matrix.v1.BrightnessReq
+	17, // 19: matrix.v1.Sportsmatrix.GetBrightness:input_type -> google.protobuf.Empty
+	17, // 20: matrix.v1.Config.GetConfig:input_type -> google.protobuf.Empty
+	17, // 21: matrix.v1.Config.GetConfigSchema:input_type -> google.protobuf.Empty
+	16, // 22: matrix.v1.Config.UpdateConfig:input_type -> matrix.v1.UpdateConfigReq
+	0,  // 23: matrix.v1.Sportsmatrix.Version:output_type -> matrix.v1.VersionResp
+	17, // 24: matrix.v1.Sportsmatrix.ScreenOn:output_type -> google.protobuf.Empty
+	17, // 25: matrix.v1.Sportsmatrix.ScreenOff:output_type -> google.protobuf.Empty
+	1,  // 26: matrix.v1.Sportsmatrix.GetStatus:output_type -> matrix.v1.Status
+	17, // 27: matrix.v1.Sportsmatrix.SetStatus:output_type -> google.protobuf.Empty
+	17, // 28: matrix.v1.Sportsmatrix.SetAll:output_type -> google.protobuf.Empty
+	17, // 29: matrix.v1.Sportsmatrix.Jump:output_type -> google.protobuf.Empty
+	17, // 30: matrix.v1.Sportsmatrix.NextBoard:output_type -> google.protobuf.Empty
+	17, // 31: matrix.v1.Sportsmatrix.RestartService:output_type -> google.protobuf.Empty
+	17, // 32: matrix.v1.Sportsmatrix.SetLiveOnly:output_type -> google.protobuf.Empty
+	17, // 33: matrix.v1.Sportsmatrix.SetPlaylist:output_type -> google.protobuf.Empty
+	6,  // 34: matrix.v1.Sportsmatrix.GetPlaylists:output_type -> matrix.v1.PlaylistsResp
+	7,  // 35: matrix.v1.Sportsmatrix.ReloadConfig:output_type -> matrix.v1.ReloadConfigResp
+	17, // 36: matrix.v1.Sportsmatrix.ResetState:output_type -> google.protobuf.Empty
+	9,  // 37: matrix.v1.Sportsmatrix.Record:output_type -> matrix.v1.RecordResp
+	17, // 38: matrix.v1.Sportsmatrix.StopRecording:output_type -> google.protobuf.Empty
+	11, // 39: matrix.v1.Sportsmatrix.GetHealth:output_type -> matrix.v1.HealthResp
+	17, // 40: matrix.v1.Sportsmatrix.SetBrightness:output_type -> google.protobuf.Empty
+	13, // 41: matrix.v1.Sportsmatrix.GetBrightness:output_type -> matrix.v1.BrightnessResp
+	14, // 42: matrix.v1.Config.GetConfig:output_type -> matrix.v1.ConfigResp
+	15, // 43: matrix.v1.Config.GetConfigSchema:output_type -> matrix.v1.ConfigSchemaResp
+	7,  // 44: matrix.v1.Config.UpdateConfig:output_type -> matrix.v1.ReloadConfigResp
+	23, // [23:45] is the sub-list for method output_type
+	1,  // [1:23] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_sportsmatrix_sportsmatrix_proto_init() }
@@ -456,6 +1274,150 @@ func file_sportsmatrix_sportsmatrix_proto_init() {
 				return nil
 			}
 		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrightnessReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrightnessResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigSchemaResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportsmatrix_sportsmatrix_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -463,9 +1425,9 @@ func file_sportsmatrix_sportsmatrix_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sportsmatrix_sportsmatrix_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   17,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_sportsmatrix_sportsmatrix_proto_goTypes,
 		DependencyIndexes: file_sportsmatrix_sportsmatrix_proto_depIdxs,
@@ -54,6 +54,24 @@ type Sportsmatrix interface {
 	RestartService(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
 
 	SetLiveOnly(context.Context, *LiveOnlyReq) (*google_protobuf.Empty, error)
+
+	SetPlaylist(context.Context, *PlaylistReq) (*google_protobuf.Empty, error)
+
+	GetPlaylists(context.Context, *google_protobuf.Empty) (*PlaylistsResp, error)
+
+	ReloadConfig(context.Context, *google_protobuf.Empty) (*ReloadConfigResp, error)
+
+	ResetState(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
+
+	Record(context.Context, *RecordReq) (*RecordResp, error)
+
+	StopRecording(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
+
+	GetHealth(context.Context, *google_protobuf.Empty) (*HealthResp, error)
+
+	SetBrightness(context.Context, *BrightnessReq) (*google_protobuf.Empty, error)
+
+	GetBrightness(context.Context, *google_protobuf.Empty) (*BrightnessResp, error)
 }
 
 // ============================
@@ -62,7 +80,7 @@ type Sportsmatrix interface {
 
 type sportsmatrixProtobufClient struct {
 	client      HTTPClient
-	urls        [10]string
+	urls        [19]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -90,7 +108,7 @@ func NewSportsmatrixProtobufClient(baseURL string, client HTTPClient, opts ...tw
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "matrix.v1", "Sportsmatrix")
-	urls := [10]string{
+	urls := [19]string{
 		serviceURL + "Version",
 		serviceURL + "ScreenOn",
 		serviceURL + "ScreenOff",
@@ -101,6 +119,15 @@ func NewSportsmatrixProtobufClient(baseURL string, client HTTPClient, opts ...tw
 		serviceURL + "NextBoard",
 		serviceURL + "RestartService",
 		serviceURL + "SetLiveOnly",
+		serviceURL + "SetPlaylist",
+		serviceURL + "GetPlaylists",
+		serviceURL + "ReloadConfig",
+		serviceURL + "ResetState",
+		serviceURL + "Record",
+		serviceURL + "StopRecording",
+		serviceURL + "GetHealth",
+		serviceURL + "SetBrightness",
+		serviceURL + "GetBrightness",
 	}
 
 	return &sportsmatrixProtobufClient{
@@ -571,81 +598,72 @@ func (c *sportsmatrixProtobufClient) callSetLiveOnly(ctx context.Context, in *Li
 	return out, nil
 }
 
-// ========================
-// Sportsmatrix JSON Client
-// ========================
-
-type sportsmatrixJSONClient struct {
-	client      HTTPClient
-	urls        [10]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
-}
-
-// NewSportsmatrixJSONClient creates a JSON client that implements the Sportsmatrix interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewSportsmatrixJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Sportsmatrix {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
-	}
-
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
+func (c *sportsmatrixProtobufClient) SetPlaylist(ctx context.Context, in *PlaylistReq) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlaylist")
+	caller := c.callSetPlaylist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PlaylistReq) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PlaylistReq) when calling interceptor")
+					}
+					return c.callSetPlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (c *sportsmatrixProtobufClient) callSetPlaylist(ctx context.Context, in *PlaylistReq) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "matrix.v1", "Sportsmatrix")
-	urls := [10]string{
-		serviceURL + "Version",
-		serviceURL + "ScreenOn",
-		serviceURL + "ScreenOff",
-		serviceURL + "GetStatus",
-		serviceURL + "SetStatus",
-		serviceURL + "SetAll",
-		serviceURL + "Jump",
-		serviceURL + "NextBoard",
-		serviceURL + "RestartService",
-		serviceURL + "SetLiveOnly",
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	return &sportsmatrixJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
-	}
+	return out, nil
 }
 
-func (c *sportsmatrixJSONClient) Version(ctx context.Context, in *google_protobuf.Empty) (*VersionResp, error) {
+func (c *sportsmatrixProtobufClient) GetPlaylists(ctx context.Context, in *google_protobuf.Empty) (*PlaylistsResp, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
-	ctx = ctxsetters.WithMethodName(ctx, "Version")
-	caller := c.callVersion
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylists")
+	caller := c.callGetPlaylists
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf.Empty) (*VersionResp, error) {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*PlaylistsResp, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return c.callVersion(ctx, typedReq)
+					return c.callGetPlaylists(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*VersionResp)
+				typedResp, ok := resp.(*PlaylistsResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*VersionResp) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*PlaylistsResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -655,9 +673,9 @@ func (c *sportsmatrixJSONClient) Version(ctx context.Context, in *google_protobu
 	return caller(ctx, in)
 }
 
-func (c *sportsmatrixJSONClient) callVersion(ctx context.Context, in *google_protobuf.Empty) (*VersionResp, error) {
-	out := new(VersionResp)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (c *sportsmatrixProtobufClient) callGetPlaylists(ctx context.Context, in *google_protobuf.Empty) (*PlaylistsResp, error) {
+	out := new(PlaylistsResp)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -672,26 +690,26 @@ func (c *sportsmatrixJSONClient) callVersion(ctx context.Context, in *google_pro
 	return out, nil
 }
 
-func (c *sportsmatrixJSONClient) ScreenOn(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+func (c *sportsmatrixProtobufClient) ReloadConfig(ctx context.Context, in *google_protobuf.Empty) (*ReloadConfigResp, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
-	ctx = ctxsetters.WithMethodName(ctx, "ScreenOn")
-	caller := c.callScreenOn
+	ctx = ctxsetters.WithMethodName(ctx, "ReloadConfig")
+	caller := c.callReloadConfig
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*ReloadConfigResp, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return c.callScreenOn(ctx, typedReq)
+					return c.callReloadConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*ReloadConfigResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ReloadConfigResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -701,9 +719,9 @@ func (c *sportsmatrixJSONClient) ScreenOn(ctx context.Context, in *google_protob
 	return caller(ctx, in)
 }
 
-func (c *sportsmatrixJSONClient) callScreenOn(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (c *sportsmatrixProtobufClient) callReloadConfig(ctx context.Context, in *google_protobuf.Empty) (*ReloadConfigResp, error) {
+	out := new(ReloadConfigResp)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -718,11 +736,11 @@ func (c *sportsmatrixJSONClient) callScreenOn(ctx context.Context, in *google_pr
 	return out, nil
 }
 
-func (c *sportsmatrixJSONClient) ScreenOff(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+func (c *sportsmatrixProtobufClient) ResetState(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
-	ctx = ctxsetters.WithMethodName(ctx, "ScreenOff")
-	caller := c.callScreenOff
+	ctx = ctxsetters.WithMethodName(ctx, "ResetState")
+	caller := c.callResetState
 	if c.interceptor != nil {
 		caller = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
@@ -731,7 +749,7 @@ func (c *sportsmatrixJSONClient) ScreenOff(ctx context.Context, in *google_proto
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return c.callScreenOff(ctx, typedReq)
+					return c.callResetState(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -747,9 +765,9 @@ func (c *sportsmatrixJSONClient) ScreenOff(ctx context.Context, in *google_proto
 	return caller(ctx, in)
 }
 
-func (c *sportsmatrixJSONClient) callScreenOff(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+func (c *sportsmatrixProtobufClient) callResetState(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -764,26 +782,26 @@ func (c *sportsmatrixJSONClient) callScreenOff(ctx context.Context, in *google_p
 	return out, nil
 }
 
-func (c *sportsmatrixJSONClient) GetStatus(ctx context.Context, in *google_protobuf.Empty) (*Status, error) {
+func (c *sportsmatrixProtobufClient) Record(ctx context.Context, in *RecordReq) (*RecordResp, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
-	ctx = ctxsetters.WithMethodName(ctx, "GetStatus")
-	caller := c.callGetStatus
+	ctx = ctxsetters.WithMethodName(ctx, "Record")
+	caller := c.callRecord
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf.Empty) (*Status, error) {
+		caller = func(ctx context.Context, req *RecordReq) (*RecordResp, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*RecordReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RecordReq) when calling interceptor")
 					}
-					return c.callGetStatus(ctx, typedReq)
+					return c.callRecord(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Status)
+				typedResp, ok := resp.(*RecordResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Status) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RecordResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -793,9 +811,9 @@ func (c *sportsmatrixJSONClient) GetStatus(ctx context.Context, in *google_proto
 	return caller(ctx, in)
 }
 
-func (c *sportsmatrixJSONClient) callGetStatus(ctx context.Context, in *google_protobuf.Empty) (*Status, error) {
-	out := new(Status)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+func (c *sportsmatrixProtobufClient) callRecord(ctx context.Context, in *RecordReq) (*RecordResp, error) {
+	out := new(RecordResp)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -810,20 +828,20 @@ func (c *sportsmatrixJSONClient) callGetStatus(ctx context.Context, in *google_p
 	return out, nil
 }
 
-func (c *sportsmatrixJSONClient) SetStatus(ctx context.Context, in *Status) (*google_protobuf.Empty, error) {
+func (c *sportsmatrixProtobufClient) StopRecording(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
-	ctx = ctxsetters.WithMethodName(ctx, "SetStatus")
-	caller := c.callSetStatus
+	ctx = ctxsetters.WithMethodName(ctx, "StopRecording")
+	caller := c.callStopRecording
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *Status) (*google_protobuf.Empty, error) {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Status)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Status) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return c.callSetStatus(ctx, typedReq)
+					return c.callStopRecording(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -839,9 +857,9 @@ func (c *sportsmatrixJSONClient) SetStatus(ctx context.Context, in *Status) (*go
 	return caller(ctx, in)
 }
 
-func (c *sportsmatrixJSONClient) callSetStatus(ctx context.Context, in *Status) (*google_protobuf.Empty, error) {
+func (c *sportsmatrixProtobufClient) callStopRecording(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -856,26 +874,26 @@ func (c *sportsmatrixJSONClient) callSetStatus(ctx context.Context, in *Status)
 	return out, nil
 }
 
-func (c *sportsmatrixJSONClient) SetAll(ctx context.Context, in *SetAllReq) (*google_protobuf.Empty, error) {
+func (c *sportsmatrixProtobufClient) GetHealth(ctx context.Context, in *google_protobuf.Empty) (*HealthResp, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
-	ctx = ctxsetters.WithMethodName(ctx, "SetAll")
-	caller := c.callSetAll
+	ctx = ctxsetters.WithMethodName(ctx, "GetHealth")
+	caller := c.callGetHealth
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *SetAllReq) (*google_protobuf.Empty, error) {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*HealthResp, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SetAllReq)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SetAllReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return c.callSetAll(ctx, typedReq)
+					return c.callGetHealth(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*HealthResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*HealthResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -885,9 +903,9 @@ func (c *sportsmatrixJSONClient) SetAll(ctx context.Context, in *SetAllReq) (*go
 	return caller(ctx, in)
 }
 
-func (c *sportsmatrixJSONClient) callSetAll(ctx context.Context, in *SetAllReq) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+func (c *sportsmatrixProtobufClient) callGetHealth(ctx context.Context, in *google_protobuf.Empty) (*HealthResp, error) {
+	out := new(HealthResp)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -902,20 +920,20 @@ func (c *sportsmatrixJSONClient) callSetAll(ctx context.Context, in *SetAllReq)
 	return out, nil
 }
 
-func (c *sportsmatrixJSONClient) Jump(ctx context.Context, in *JumpReq) (*google_protobuf.Empty, error) {
+func (c *sportsmatrixProtobufClient) SetBrightness(ctx context.Context, in *BrightnessReq) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
-	ctx = ctxsetters.WithMethodName(ctx, "Jump")
-	caller := c.callJump
+	ctx = ctxsetters.WithMethodName(ctx, "SetBrightness")
+	caller := c.callSetBrightness
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *JumpReq) (*google_protobuf.Empty, error) {
+		caller = func(ctx context.Context, req *BrightnessReq) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*JumpReq)
+					typedReq, ok := req.(*BrightnessReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*JumpReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*BrightnessReq) when calling interceptor")
 					}
-					return c.callJump(ctx, typedReq)
+					return c.callSetBrightness(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -931,9 +949,9 @@ func (c *sportsmatrixJSONClient) Jump(ctx context.Context, in *JumpReq) (*google
 	return caller(ctx, in)
 }
 
-func (c *sportsmatrixJSONClient) callJump(ctx context.Context, in *JumpReq) (*google_protobuf.Empty, error) {
+func (c *sportsmatrixProtobufClient) callSetBrightness(ctx context.Context, in *BrightnessReq) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -948,26 +966,26 @@ func (c *sportsmatrixJSONClient) callJump(ctx context.Context, in *JumpReq) (*go
 	return out, nil
 }
 
-func (c *sportsmatrixJSONClient) NextBoard(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+func (c *sportsmatrixProtobufClient) GetBrightness(ctx context.Context, in *google_protobuf.Empty) (*BrightnessResp, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
-	ctx = ctxsetters.WithMethodName(ctx, "NextBoard")
-	caller := c.callNextBoard
+	ctx = ctxsetters.WithMethodName(ctx, "GetBrightness")
+	caller := c.callGetBrightness
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*BrightnessResp, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return c.callNextBoard(ctx, typedReq)
+					return c.callGetBrightness(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*BrightnessResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*BrightnessResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -977,9 +995,9 @@ func (c *sportsmatrixJSONClient) NextBoard(ctx context.Context, in *google_proto
 	return caller(ctx, in)
 }
 
-func (c *sportsmatrixJSONClient) callNextBoard(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+func (c *sportsmatrixProtobufClient) callGetBrightness(ctx context.Context, in *google_protobuf.Empty) (*BrightnessResp, error) {
+	out := new(BrightnessResp)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -994,26 +1012,90 @@ func (c *sportsmatrixJSONClient) callNextBoard(ctx context.Context, in *google_p
 	return out, nil
 }
 
-func (c *sportsmatrixJSONClient) RestartService(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+// ========================
+// Sportsmatrix JSON Client
+// ========================
+
+type sportsmatrixJSONClient struct {
+	client      HTTPClient
+	urls        [19]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewSportsmatrixJSONClient creates a JSON client that implements the Sportsmatrix interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewSportsmatrixJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Sportsmatrix {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "matrix.v1", "Sportsmatrix")
+	urls := [19]string{
+		serviceURL + "Version",
+		serviceURL + "ScreenOn",
+		serviceURL + "ScreenOff",
+		serviceURL + "GetStatus",
+		serviceURL + "SetStatus",
+		serviceURL + "SetAll",
+		serviceURL + "Jump",
+		serviceURL + "NextBoard",
+		serviceURL + "RestartService",
+		serviceURL + "SetLiveOnly",
+		serviceURL + "SetPlaylist",
+		serviceURL + "GetPlaylists",
+		serviceURL + "ReloadConfig",
+		serviceURL + "ResetState",
+		serviceURL + "Record",
+		serviceURL + "StopRecording",
+		serviceURL + "GetHealth",
+		serviceURL + "SetBrightness",
+		serviceURL + "GetBrightness",
+	}
+
+	return &sportsmatrixJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *sportsmatrixJSONClient) Version(ctx context.Context, in *google_protobuf.Empty) (*VersionResp, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
-	ctx = ctxsetters.WithMethodName(ctx, "RestartService")
-	caller := c.callRestartService
+	ctx = ctxsetters.WithMethodName(ctx, "Version")
+	caller := c.callVersion
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*VersionResp, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return c.callRestartService(ctx, typedReq)
+					return c.callVersion(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*VersionResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*VersionResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1023,9 +1105,9 @@ func (c *sportsmatrixJSONClient) RestartService(ctx context.Context, in *google_
 	return caller(ctx, in)
 }
 
-func (c *sportsmatrixJSONClient) callRestartService(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+func (c *sportsmatrixJSONClient) callVersion(ctx context.Context, in *google_protobuf.Empty) (*VersionResp, error) {
+	out := new(VersionResp)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1040,20 +1122,20 @@ func (c *sportsmatrixJSONClient) callRestartService(ctx context.Context, in *goo
 	return out, nil
 }
 
-func (c *sportsmatrixJSONClient) SetLiveOnly(ctx context.Context, in *LiveOnlyReq) (*google_protobuf.Empty, error) {
+func (c *sportsmatrixJSONClient) ScreenOn(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
-	ctx = ctxsetters.WithMethodName(ctx, "SetLiveOnly")
-	caller := c.callSetLiveOnly
+	ctx = ctxsetters.WithMethodName(ctx, "ScreenOn")
+	caller := c.callScreenOn
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *LiveOnlyReq) (*google_protobuf.Empty, error) {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*LiveOnlyReq)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*LiveOnlyReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return c.callSetLiveOnly(ctx, typedReq)
+					return c.callScreenOn(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1069,9 +1151,9 @@ func (c *sportsmatrixJSONClient) SetLiveOnly(ctx context.Context, in *LiveOnlyRe
 	return caller(ctx, in)
 }
 
-func (c *sportsmatrixJSONClient) callSetLiveOnly(ctx context.Context, in *LiveOnlyReq) (*google_protobuf.Empty, error) {
+func (c *sportsmatrixJSONClient) callScreenOn(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1086,141 +1168,3470 @@ func (c *sportsmatrixJSONClient) callSetLiveOnly(ctx context.Context, in *LiveOn
 	return out, nil
 }
 
-// ===========================
-// Sportsmatrix Server Handler
-// ===========================
-
-type sportsmatrixServer struct {
-	Sportsmatrix
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+func (c *sportsmatrixJSONClient) ScreenOff(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "ScreenOff")
+	caller := c.callScreenOff
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callScreenOff(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewSportsmatrixServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewSportsmatrixServer(svc Sportsmatrix, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
-
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (c *sportsmatrixJSONClient) callScreenOff(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	return &sportsmatrixServer{
-		Sportsmatrix:     svc,
-		hooks:            serverOpts.Hooks,
-		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:       pathPrefix,
-		jsonSkipDefaults: jsonSkipDefaults,
-		jsonCamelCase:    jsonCamelCase,
-	}
-}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *sportsmatrixServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
+	return out, nil
 }
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *sportsmatrixServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
-		return
-	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
-		return
+func (c *sportsmatrixJSONClient) GetStatus(ctx context.Context, in *google_protobuf.Empty) (*Status, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "GetStatus")
+	caller := c.callGetStatus
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*Status, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Status)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Status) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+	return caller(ctx, in)
 }
 
-// SportsmatrixPathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const SportsmatrixPathPrefix = "/twirp/matrix.v1.Sportsmatrix/"
+func (c *sportsmatrixJSONClient) callGetStatus(ctx context.Context, in *google_protobuf.Empty) (*Status, error) {
+	out := new(Status)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
 
-func (s *sportsmatrixServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) SetStatus(ctx context.Context, in *Status) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	ctx = ctxsetters.WithMethodName(ctx, "SetStatus")
+	caller := c.callSetStatus
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *Status) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Status)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Status) when calling interceptor")
+					}
+					return c.callSetStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
+func (c *sportsmatrixJSONClient) callSetStatus(ctx context.Context, in *Status) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "matrix.v1.Sportsmatrix" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) SetAll(ctx context.Context, in *SetAllReq) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "SetAll")
+	caller := c.callSetAll
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetAllReq) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetAllReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetAllReq) when calling interceptor")
+					}
+					return c.callSetAll(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callSetAll(ctx context.Context, in *SetAllReq) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	switch method {
-	case "Version":
-		s.serveVersion(ctx, resp, req)
-		return
-	case "ScreenOn":
-		s.serveScreenOn(ctx, resp, req)
-		return
-	case "ScreenOff":
-		s.serveScreenOff(ctx, resp, req)
-		return
-	case "GetStatus":
-		s.serveGetStatus(ctx, resp, req)
-		return
-	case "SetStatus":
-		s.serveSetStatus(ctx, resp, req)
-		return
-	case "SetAll":
-		s.serveSetAll(ctx, resp, req)
-		return
-	case "Jump":
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) Jump(ctx context.Context, in *JumpReq) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "Jump")
+	caller := c.callJump
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *JumpReq) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*JumpReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*JumpReq) when calling interceptor")
+					}
+					return c.callJump(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callJump(ctx context.Context, in *JumpReq) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) NextBoard(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "NextBoard")
+	caller := c.callNextBoard
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callNextBoard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callNextBoard(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) RestartService(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "RestartService")
+	caller := c.callRestartService
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callRestartService(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callRestartService(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) SetLiveOnly(ctx context.Context, in *LiveOnlyReq) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "SetLiveOnly")
+	caller := c.callSetLiveOnly
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LiveOnlyReq) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LiveOnlyReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LiveOnlyReq) when calling interceptor")
+					}
+					return c.callSetLiveOnly(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callSetLiveOnly(ctx context.Context, in *LiveOnlyReq) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) SetPlaylist(ctx context.Context, in *PlaylistReq) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlaylist")
+	caller := c.callSetPlaylist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PlaylistReq) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PlaylistReq) when calling interceptor")
+					}
+					return c.callSetPlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callSetPlaylist(ctx context.Context, in *PlaylistReq) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) GetPlaylists(ctx context.Context, in *google_protobuf.Empty) (*PlaylistsResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylists")
+	caller := c.callGetPlaylists
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*PlaylistsResp, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetPlaylists(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PlaylistsResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PlaylistsResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callGetPlaylists(ctx context.Context, in *google_protobuf.Empty) (*PlaylistsResp, error) {
+	out := new(PlaylistsResp)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) ReloadConfig(ctx context.Context, in *google_protobuf.Empty) (*ReloadConfigResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "ReloadConfig")
+	caller := c.callReloadConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*ReloadConfigResp, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callReloadConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReloadConfigResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReloadConfigResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callReloadConfig(ctx context.Context, in *google_protobuf.Empty) (*ReloadConfigResp, error) {
+	out := new(ReloadConfigResp)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) ResetState(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "ResetState")
+	caller := c.callResetState
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callResetState(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callResetState(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) Record(ctx context.Context, in *RecordReq) (*RecordResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "Record")
+	caller := c.callRecord
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RecordReq) (*RecordResp, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecordReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecordReq) when calling interceptor")
+					}
+					return c.callRecord(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecordResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecordResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callRecord(ctx context.Context, in *RecordReq) (*RecordResp, error) {
+	out := new(RecordResp)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) StopRecording(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "StopRecording")
+	caller := c.callStopRecording
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callStopRecording(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callStopRecording(ctx context.Context, in *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) GetHealth(ctx context.Context, in *google_protobuf.Empty) (*HealthResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "GetHealth")
+	caller := c.callGetHealth
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*HealthResp, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetHealth(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*HealthResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*HealthResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callGetHealth(ctx context.Context, in *google_protobuf.Empty) (*HealthResp, error) {
+	out := new(HealthResp)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) SetBrightness(ctx context.Context, in *BrightnessReq) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "SetBrightness")
+	caller := c.callSetBrightness
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *BrightnessReq) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BrightnessReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BrightnessReq) when calling interceptor")
+					}
+					return c.callSetBrightness(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callSetBrightness(ctx context.Context, in *BrightnessReq) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sportsmatrixJSONClient) GetBrightness(ctx context.Context, in *google_protobuf.Empty) (*BrightnessResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithMethodName(ctx, "GetBrightness")
+	caller := c.callGetBrightness
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*BrightnessResp, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetBrightness(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*BrightnessResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*BrightnessResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sportsmatrixJSONClient) callGetBrightness(ctx context.Context, in *google_protobuf.Empty) (*BrightnessResp, error) {
+	out := new(BrightnessResp)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===========================
+// Sportsmatrix Server Handler
+// ===========================
+
+type sportsmatrixServer struct {
+	Sportsmatrix
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewSportsmatrixServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewSportsmatrixServer(svc Sportsmatrix, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &sportsmatrixServer{
+		Sportsmatrix:     svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *sportsmatrixServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *sportsmatrixServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// SportsmatrixPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const SportsmatrixPathPrefix = "/twirp/matrix.v1.Sportsmatrix/"
+
+func (s *sportsmatrixServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "matrix.v1.Sportsmatrix" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "Version":
+		s.serveVersion(ctx, resp, req)
+		return
+	case "ScreenOn":
+		s.serveScreenOn(ctx, resp, req)
+		return
+	case "ScreenOff":
+		s.serveScreenOff(ctx, resp, req)
+		return
+	case "GetStatus":
+		s.serveGetStatus(ctx, resp, req)
+		return
+	case "SetStatus":
+		s.serveSetStatus(ctx, resp, req)
+		return
+	case "SetAll":
+		s.serveSetAll(ctx, resp, req)
+		return
+	case "Jump":
 		s.serveJump(ctx, resp, req)
 		return
-	case "NextBoard":
-		s.serveNextBoard(ctx, resp, req)
+	case "NextBoard":
+		s.serveNextBoard(ctx, resp, req)
+		return
+	case "RestartService":
+		s.serveRestartService(ctx, resp, req)
+		return
+	case "SetLiveOnly":
+		s.serveSetLiveOnly(ctx, resp, req)
+		return
+	case "SetPlaylist":
+		s.serveSetPlaylist(ctx, resp, req)
+		return
+	case "GetPlaylists":
+		s.serveGetPlaylists(ctx, resp, req)
+		return
+	case "ReloadConfig":
+		s.serveReloadConfig(ctx, resp, req)
+		return
+	case "ResetState":
+		s.serveResetState(ctx, resp, req)
+		return
+	case "Record":
+		s.serveRecord(ctx, resp, req)
+		return
+	case "StopRecording":
+		s.serveStopRecording(ctx, resp, req)
+		return
+	case "GetHealth":
+		s.serveGetHealth(ctx, resp, req)
+		return
+	case "SetBrightness":
+		s.serveSetBrightness(ctx, resp, req)
+		return
+	case "GetBrightness":
+		s.serveGetBrightness(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *sportsmatrixServer) serveVersion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveVersionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveVersionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveVersionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Version")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.Version
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*VersionResp, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.Version(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VersionResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VersionResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *VersionResp
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *VersionResp and nil error while calling Version. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveVersionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Version")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.Version
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*VersionResp, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.Version(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VersionResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VersionResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *VersionResp
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *VersionResp and nil error while calling Version. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveScreenOn(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveScreenOnJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveScreenOnProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveScreenOnJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ScreenOn")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.ScreenOn
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.ScreenOn(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ScreenOn. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveScreenOnProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ScreenOn")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.ScreenOn
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.ScreenOn(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ScreenOn. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveScreenOff(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveScreenOffJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveScreenOffProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveScreenOffJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ScreenOff")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.ScreenOff
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.ScreenOff(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ScreenOff. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveScreenOffProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ScreenOff")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.ScreenOff
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.ScreenOff(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ScreenOff. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveGetStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetStatusJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetStatusProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveGetStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.GetStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*Status, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.GetStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Status)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Status) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Status
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Status and nil error while calling GetStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveGetStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.GetStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*Status, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.GetStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Status)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Status) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Status
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Status and nil error while calling GetStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveSetStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetStatusJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetStatusProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveSetStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(Status)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.SetStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Status) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Status)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Status) when calling interceptor")
+					}
+					return s.Sportsmatrix.SetStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveSetStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(Status)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.SetStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Status) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Status)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Status) when calling interceptor")
+					}
+					return s.Sportsmatrix.SetStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveSetAll(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetAllJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetAllProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveSetAllJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetAll")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SetAllReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.SetAll
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetAllReq) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetAllReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetAllReq) when calling interceptor")
+					}
+					return s.Sportsmatrix.SetAll(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetAll. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveSetAllProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetAll")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SetAllReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.SetAll
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetAllReq) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetAllReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetAllReq) when calling interceptor")
+					}
+					return s.Sportsmatrix.SetAll(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetAll. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveJump(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveJumpJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveJumpProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveJumpJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Jump")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(JumpReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.Jump
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *JumpReq) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*JumpReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*JumpReq) when calling interceptor")
+					}
+					return s.Sportsmatrix.Jump(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling Jump. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveJumpProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Jump")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(JumpReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.Jump
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *JumpReq) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*JumpReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*JumpReq) when calling interceptor")
+					}
+					return s.Sportsmatrix.Jump(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling Jump. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveNextBoard(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveNextBoardJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveNextBoardProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveNextBoardJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "NextBoard")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.NextBoard
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.NextBoard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling NextBoard. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveNextBoardProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "NextBoard")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.NextBoard
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.NextBoard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling NextBoard. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveRestartService(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRestartServiceJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRestartServiceProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveRestartServiceJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RestartService")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.RestartService
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.RestartService(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RestartService. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveRestartServiceProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RestartService")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.RestartService
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.RestartService(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RestartService. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveSetLiveOnly(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetLiveOnlyJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetLiveOnlyProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveSetLiveOnlyJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetLiveOnly")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(LiveOnlyReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.SetLiveOnly
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LiveOnlyReq) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LiveOnlyReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LiveOnlyReq) when calling interceptor")
+					}
+					return s.Sportsmatrix.SetLiveOnly(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetLiveOnly. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveSetLiveOnlyProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetLiveOnly")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(LiveOnlyReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.SetLiveOnly
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LiveOnlyReq) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LiveOnlyReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LiveOnlyReq) when calling interceptor")
+					}
+					return s.Sportsmatrix.SetLiveOnly(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetLiveOnly. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveSetPlaylist(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetPlaylistJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetPlaylistProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveSetPlaylistJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlaylist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PlaylistReq)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.SetPlaylist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PlaylistReq) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PlaylistReq) when calling interceptor")
+					}
+					return s.Sportsmatrix.SetPlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetPlaylist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveSetPlaylistProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlaylist")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PlaylistReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.SetPlaylist
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PlaylistReq) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PlaylistReq)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PlaylistReq) when calling interceptor")
+					}
+					return s.Sportsmatrix.SetPlaylist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetPlaylist. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveGetPlaylists(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetPlaylistsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetPlaylistsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveGetPlaylistsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylists")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.GetPlaylists
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*PlaylistsResp, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.GetPlaylists(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PlaylistsResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PlaylistsResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PlaylistsResp
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PlaylistsResp and nil error while calling GetPlaylists. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveGetPlaylistsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlaylists")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.GetPlaylists
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*PlaylistsResp, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.GetPlaylists(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PlaylistsResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PlaylistsResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PlaylistsResp
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PlaylistsResp and nil error while calling GetPlaylists. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveReloadConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveReloadConfigJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveReloadConfigProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveReloadConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReloadConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.ReloadConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*ReloadConfigResp, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.ReloadConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReloadConfigResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReloadConfigResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ReloadConfigResp
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReloadConfigResp and nil error while calling ReloadConfig. nil responses are not supported"))
 		return
-	case "RestartService":
-		s.serveRestartService(ctx, resp, req)
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
 		return
-	case "SetLiveOnly":
-		s.serveSetLiveOnly(ctx, resp, req)
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveReloadConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReloadConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.ReloadConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*ReloadConfigResp, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.ReloadConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReloadConfigResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReloadConfigResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ReloadConfigResp
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReloadConfigResp and nil error while calling ReloadConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveResetState(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveResetStateJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveResetStateProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sportsmatrixServer) serveResetStateJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ResetState")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Sportsmatrix.ResetState
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.ResetState(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ResetState. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sportsmatrixServer) serveResetStateProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ResetState")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Sportsmatrix.ResetState
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Sportsmatrix.ResetState(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ResetState. nil responses are not supported"))
 		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveVersion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveRecord(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1228,9 +4639,9 @@ func (s *sportsmatrixServer) serveVersion(ctx context.Context, resp http.Respons
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveVersionJSON(ctx, resp, req)
+		s.serveRecordJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveVersionProtobuf(ctx, resp, req)
+		s.serveRecordProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1238,9 +4649,9 @@ func (s *sportsmatrixServer) serveVersion(ctx context.Context, resp http.Respons
 	}
 }
 
-func (s *sportsmatrixServer) serveVersionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveRecordJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "Version")
+	ctx = ctxsetters.WithMethodName(ctx, "Record")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1253,29 +4664,29 @@ func (s *sportsmatrixServer) serveVersionJSON(ctx context.Context, resp http.Res
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(google_protobuf.Empty)
+	reqContent := new(RecordReq)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Sportsmatrix.Version
+	handler := s.Sportsmatrix.Record
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*VersionResp, error) {
+		handler = func(ctx context.Context, req *RecordReq) (*RecordResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*RecordReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RecordReq) when calling interceptor")
 					}
-					return s.Sportsmatrix.Version(ctx, typedReq)
+					return s.Sportsmatrix.Record(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*VersionResp)
+				typedResp, ok := resp.(*RecordResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*VersionResp) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RecordResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1284,7 +4695,7 @@ func (s *sportsmatrixServer) serveVersionJSON(ctx context.Context, resp http.Res
 	}
 
 	// Call service method
-	var respContent *VersionResp
+	var respContent *RecordResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1295,7 +4706,7 @@ func (s *sportsmatrixServer) serveVersionJSON(ctx context.Context, resp http.Res
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *VersionResp and nil error while calling Version. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RecordResp and nil error while calling Record. nil responses are not supported"))
 		return
 	}
 
@@ -1321,9 +4732,9 @@ func (s *sportsmatrixServer) serveVersionJSON(ctx context.Context, resp http.Res
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveVersionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveRecordProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "Version")
+	ctx = ctxsetters.WithMethodName(ctx, "Record")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1335,28 +4746,28 @@ func (s *sportsmatrixServer) serveVersionProtobuf(ctx context.Context, resp http
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(google_protobuf.Empty)
+	reqContent := new(RecordReq)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Sportsmatrix.Version
+	handler := s.Sportsmatrix.Record
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*VersionResp, error) {
+		handler = func(ctx context.Context, req *RecordReq) (*RecordResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*RecordReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RecordReq) when calling interceptor")
 					}
-					return s.Sportsmatrix.Version(ctx, typedReq)
+					return s.Sportsmatrix.Record(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*VersionResp)
+				typedResp, ok := resp.(*RecordResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*VersionResp) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RecordResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1365,7 +4776,7 @@ func (s *sportsmatrixServer) serveVersionProtobuf(ctx context.Context, resp http
 	}
 
 	// Call service method
-	var respContent *VersionResp
+	var respContent *RecordResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1376,7 +4787,7 @@ func (s *sportsmatrixServer) serveVersionProtobuf(ctx context.Context, resp http
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *VersionResp and nil error while calling Version. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RecordResp and nil error while calling Record. nil responses are not supported"))
 		return
 	}
 
@@ -1400,7 +4811,7 @@ func (s *sportsmatrixServer) serveVersionProtobuf(ctx context.Context, resp http
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveScreenOn(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveStopRecording(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1408,9 +4819,9 @@ func (s *sportsmatrixServer) serveScreenOn(ctx context.Context, resp http.Respon
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveScreenOnJSON(ctx, resp, req)
+		s.serveStopRecordingJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveScreenOnProtobuf(ctx, resp, req)
+		s.serveStopRecordingProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1418,9 +4829,9 @@ func (s *sportsmatrixServer) serveScreenOn(ctx context.Context, resp http.Respon
 	}
 }
 
-func (s *sportsmatrixServer) serveScreenOnJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveStopRecordingJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ScreenOn")
+	ctx = ctxsetters.WithMethodName(ctx, "StopRecording")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1440,7 +4851,7 @@ func (s *sportsmatrixServer) serveScreenOnJSON(ctx context.Context, resp http.Re
 		return
 	}
 
-	handler := s.Sportsmatrix.ScreenOn
+	handler := s.Sportsmatrix.StopRecording
 	if s.interceptor != nil {
 		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
@@ -1449,7 +4860,7 @@ func (s *sportsmatrixServer) serveScreenOnJSON(ctx context.Context, resp http.Re
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.ScreenOn(ctx, typedReq)
+					return s.Sportsmatrix.StopRecording(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1475,7 +4886,7 @@ func (s *sportsmatrixServer) serveScreenOnJSON(ctx context.Context, resp http.Re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ScreenOn. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling StopRecording. nil responses are not supported"))
 		return
 	}
 
@@ -1501,9 +4912,9 @@ func (s *sportsmatrixServer) serveScreenOnJSON(ctx context.Context, resp http.Re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveScreenOnProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveStopRecordingProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ScreenOn")
+	ctx = ctxsetters.WithMethodName(ctx, "StopRecording")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1521,7 +4932,7 @@ func (s *sportsmatrixServer) serveScreenOnProtobuf(ctx context.Context, resp htt
 		return
 	}
 
-	handler := s.Sportsmatrix.ScreenOn
+	handler := s.Sportsmatrix.StopRecording
 	if s.interceptor != nil {
 		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
@@ -1530,7 +4941,7 @@ func (s *sportsmatrixServer) serveScreenOnProtobuf(ctx context.Context, resp htt
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.ScreenOn(ctx, typedReq)
+					return s.Sportsmatrix.StopRecording(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1556,7 +4967,7 @@ func (s *sportsmatrixServer) serveScreenOnProtobuf(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ScreenOn. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling StopRecording. nil responses are not supported"))
 		return
 	}
 
@@ -1580,7 +4991,7 @@ func (s *sportsmatrixServer) serveScreenOnProtobuf(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveScreenOff(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveGetHealth(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1588,9 +4999,9 @@ func (s *sportsmatrixServer) serveScreenOff(ctx context.Context, resp http.Respo
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveScreenOffJSON(ctx, resp, req)
+		s.serveGetHealthJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveScreenOffProtobuf(ctx, resp, req)
+		s.serveGetHealthProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1598,9 +5009,9 @@ func (s *sportsmatrixServer) serveScreenOff(ctx context.Context, resp http.Respo
 	}
 }
 
-func (s *sportsmatrixServer) serveScreenOffJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveGetHealthJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ScreenOff")
+	ctx = ctxsetters.WithMethodName(ctx, "GetHealth")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1620,22 +5031,22 @@ func (s *sportsmatrixServer) serveScreenOffJSON(ctx context.Context, resp http.R
 		return
 	}
 
-	handler := s.Sportsmatrix.ScreenOff
+	handler := s.Sportsmatrix.GetHealth
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*HealthResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.ScreenOff(ctx, typedReq)
+					return s.Sportsmatrix.GetHealth(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*HealthResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*HealthResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1644,7 +5055,7 @@ func (s *sportsmatrixServer) serveScreenOffJSON(ctx context.Context, resp http.R
 	}
 
 	// Call service method
-	var respContent *google_protobuf.Empty
+	var respContent *HealthResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1655,7 +5066,7 @@ func (s *sportsmatrixServer) serveScreenOffJSON(ctx context.Context, resp http.R
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ScreenOff. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *HealthResp and nil error while calling GetHealth. nil responses are not supported"))
 		return
 	}
 
@@ -1681,9 +5092,9 @@ func (s *sportsmatrixServer) serveScreenOffJSON(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveScreenOffProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveGetHealthProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ScreenOff")
+	ctx = ctxsetters.WithMethodName(ctx, "GetHealth")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1701,22 +5112,22 @@ func (s *sportsmatrixServer) serveScreenOffProtobuf(ctx context.Context, resp ht
 		return
 	}
 
-	handler := s.Sportsmatrix.ScreenOff
+	handler := s.Sportsmatrix.GetHealth
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*HealthResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.ScreenOff(ctx, typedReq)
+					return s.Sportsmatrix.GetHealth(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*HealthResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*HealthResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1725,7 +5136,7 @@ func (s *sportsmatrixServer) serveScreenOffProtobuf(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *google_protobuf.Empty
+	var respContent *HealthResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1736,7 +5147,7 @@ func (s *sportsmatrixServer) serveScreenOffProtobuf(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ScreenOff. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *HealthResp and nil error while calling GetHealth. nil responses are not supported"))
 		return
 	}
 
@@ -1760,7 +5171,7 @@ func (s *sportsmatrixServer) serveScreenOffProtobuf(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveGetStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSetBrightness(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1768,9 +5179,9 @@ func (s *sportsmatrixServer) serveGetStatus(ctx context.Context, resp http.Respo
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetStatusJSON(ctx, resp, req)
+		s.serveSetBrightnessJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetStatusProtobuf(ctx, resp, req)
+		s.serveSetBrightnessProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1778,9 +5189,9 @@ func (s *sportsmatrixServer) serveGetStatus(ctx context.Context, resp http.Respo
 	}
 }
 
-func (s *sportsmatrixServer) serveGetStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSetBrightnessJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetStatus")
+	ctx = ctxsetters.WithMethodName(ctx, "SetBrightness")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1793,29 +5204,29 @@ func (s *sportsmatrixServer) serveGetStatusJSON(ctx context.Context, resp http.R
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(google_protobuf.Empty)
+	reqContent := new(BrightnessReq)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Sportsmatrix.GetStatus
+	handler := s.Sportsmatrix.SetBrightness
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*Status, error) {
+		handler = func(ctx context.Context, req *BrightnessReq) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*BrightnessReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*BrightnessReq) when calling interceptor")
 					}
-					return s.Sportsmatrix.GetStatus(ctx, typedReq)
+					return s.Sportsmatrix.SetBrightness(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Status)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Status) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1824,7 +5235,7 @@ func (s *sportsmatrixServer) serveGetStatusJSON(ctx context.Context, resp http.R
 	}
 
 	// Call service method
-	var respContent *Status
+	var respContent *google_protobuf.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1835,7 +5246,7 @@ func (s *sportsmatrixServer) serveGetStatusJSON(ctx context.Context, resp http.R
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Status and nil error while calling GetStatus. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetBrightness. nil responses are not supported"))
 		return
 	}
 
@@ -1861,9 +5272,9 @@ func (s *sportsmatrixServer) serveGetStatusJSON(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveGetStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveSetBrightnessProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetStatus")
+	ctx = ctxsetters.WithMethodName(ctx, "SetBrightness")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1875,28 +5286,28 @@ func (s *sportsmatrixServer) serveGetStatusProtobuf(ctx context.Context, resp ht
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(google_protobuf.Empty)
+	reqContent := new(BrightnessReq)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Sportsmatrix.GetStatus
+	handler := s.Sportsmatrix.SetBrightness
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*Status, error) {
+		handler = func(ctx context.Context, req *BrightnessReq) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*BrightnessReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*BrightnessReq) when calling interceptor")
 					}
-					return s.Sportsmatrix.GetStatus(ctx, typedReq)
+					return s.Sportsmatrix.SetBrightness(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*Status)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*Status) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1905,7 +5316,7 @@ func (s *sportsmatrixServer) serveGetStatusProtobuf(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *Status
+	var respContent *google_protobuf.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1916,7 +5327,7 @@ func (s *sportsmatrixServer) serveGetStatusProtobuf(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *Status and nil error while calling GetStatus. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetBrightness. nil responses are not supported"))
 		return
 	}
 
@@ -1940,7 +5351,7 @@ func (s *sportsmatrixServer) serveGetStatusProtobuf(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSetStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveGetBrightness(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1948,9 +5359,9 @@ func (s *sportsmatrixServer) serveSetStatus(ctx context.Context, resp http.Respo
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSetStatusJSON(ctx, resp, req)
+		s.serveGetBrightnessJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSetStatusProtobuf(ctx, resp, req)
+		s.serveGetBrightnessProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1958,9 +5369,9 @@ func (s *sportsmatrixServer) serveSetStatus(ctx context.Context, resp http.Respo
 	}
 }
 
-func (s *sportsmatrixServer) serveSetStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveGetBrightnessJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetStatus")
+	ctx = ctxsetters.WithMethodName(ctx, "GetBrightness")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1973,29 +5384,29 @@ func (s *sportsmatrixServer) serveSetStatusJSON(ctx context.Context, resp http.R
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(Status)
+	reqContent := new(google_protobuf.Empty)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Sportsmatrix.SetStatus
+	handler := s.Sportsmatrix.GetBrightness
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *Status) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*BrightnessResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Status)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Status) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.SetStatus(ctx, typedReq)
+					return s.Sportsmatrix.GetBrightness(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*BrightnessResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*BrightnessResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2004,7 +5415,7 @@ func (s *sportsmatrixServer) serveSetStatusJSON(ctx context.Context, resp http.R
 	}
 
 	// Call service method
-	var respContent *google_protobuf.Empty
+	var respContent *BrightnessResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2015,7 +5426,7 @@ func (s *sportsmatrixServer) serveSetStatusJSON(ctx context.Context, resp http.R
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetStatus. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *BrightnessResp and nil error while calling GetBrightness. nil responses are not supported"))
 		return
 	}
 
@@ -2041,9 +5452,9 @@ func (s *sportsmatrixServer) serveSetStatusJSON(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSetStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *sportsmatrixServer) serveGetBrightnessProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetStatus")
+	ctx = ctxsetters.WithMethodName(ctx, "GetBrightness")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2055,28 +5466,28 @@ func (s *sportsmatrixServer) serveSetStatusProtobuf(ctx context.Context, resp ht
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(Status)
+	reqContent := new(google_protobuf.Empty)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Sportsmatrix.SetStatus
+	handler := s.Sportsmatrix.GetBrightness
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *Status) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*BrightnessResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*Status)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*Status) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.SetStatus(ctx, typedReq)
+					return s.Sportsmatrix.GetBrightness(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*BrightnessResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*BrightnessResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2085,7 +5496,7 @@ func (s *sportsmatrixServer) serveSetStatusProtobuf(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *google_protobuf.Empty
+	var respContent *BrightnessResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2096,7 +5507,7 @@ func (s *sportsmatrixServer) serveSetStatusProtobuf(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetStatus. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *BrightnessResp and nil error while calling GetBrightness. nil responses are not supported"))
 		return
 	}
 
@@ -2120,367 +5531,519 @@ func (s *sportsmatrixServer) serveSetStatusProtobuf(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSetAll(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	header := req.Header.Get("Content-Type")
-	i := strings.Index(header, ";")
-	if i == -1 {
-		i = len(header)
+func (s *sportsmatrixServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 0
+}
+
+func (s *sportsmatrixServer) ProtocGenTwirpVersion() string {
+	return "v8.1.1"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *sportsmatrixServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "matrix.v1", "Sportsmatrix")
+}
+
+// ================
+// Config Interface
+// ================
+
+type Config interface {
+	GetConfig(context.Context, *google_protobuf.Empty) (*ConfigResp, error)
+
+	GetConfigSchema(context.Context, *google_protobuf.Empty) (*ConfigSchemaResp, error)
+
+	UpdateConfig(context.Context, *UpdateConfigReq) (*ReloadConfigResp, error)
+}
+
+// ======================
+// Config Protobuf Client
+// ======================
+
+type configProtobufClient struct {
+	client      HTTPClient
+	urls        [3]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewConfigProtobufClient creates a Protobuf client that implements the Config interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewConfigProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Config {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
 	}
-	switch strings.TrimSpace(strings.ToLower(header[:i])) {
-	case "application/json":
-		s.serveSetAllJSON(ctx, resp, req)
-	case "application/protobuf":
-		s.serveSetAllProtobuf(ctx, resp, req)
-	default:
-		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
-		twerr := badRouteError(msg, req.Method, req.URL.Path)
-		s.writeError(ctx, resp, twerr)
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "matrix.v1", "Config")
+	urls := [3]string{
+		serviceURL + "GetConfig",
+		serviceURL + "GetConfigSchema",
+		serviceURL + "UpdateConfig",
+	}
+
+	return &configProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *configProtobufClient) GetConfig(ctx context.Context, in *google_protobuf.Empty) (*ConfigResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Config")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfig")
+	caller := c.callGetConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*ConfigResp, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConfigResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfigResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *configProtobufClient) callGetConfig(ctx context.Context, in *google_protobuf.Empty) (*ConfigResp, error) {
+	out := new(ConfigResp)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *configProtobufClient) GetConfigSchema(ctx context.Context, in *google_protobuf.Empty) (*ConfigSchemaResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Config")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfigSchema")
+	caller := c.callGetConfigSchema
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*ConfigSchemaResp, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetConfigSchema(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConfigSchemaResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfigSchemaResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
 }
 
-func (s *sportsmatrixServer) serveSetAllJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetAll")
-	ctx, err = callRequestRouted(ctx, s.hooks)
+func (c *configProtobufClient) callGetConfigSchema(ctx context.Context, in *google_protobuf.Empty) (*ConfigSchemaResp, error) {
+	out := new(ConfigSchemaResp)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	d := json.NewDecoder(req.Body)
-	rawReqBody := json.RawMessage{}
-	if err := d.Decode(&rawReqBody); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
-	reqContent := new(SetAllReq)
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	handler := s.Sportsmatrix.SetAll
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *SetAllReq) (*google_protobuf.Empty, error) {
-			resp, err := s.interceptor(
+	return out, nil
+}
+
+func (c *configProtobufClient) UpdateConfig(ctx context.Context, in *UpdateConfigReq) (*ReloadConfigResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Config")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateConfig")
+	caller := c.callUpdateConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateConfigReq) (*ReloadConfigResp, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SetAllReq)
+					typedReq, ok := req.(*UpdateConfigReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SetAllReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateConfigReq) when calling interceptor")
 					}
-					return s.Sportsmatrix.SetAll(ctx, typedReq)
+					return c.callUpdateConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*ReloadConfigResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ReloadConfigResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *google_protobuf.Empty
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
+func (c *configProtobufClient) callUpdateConfig(ctx context.Context, in *UpdateConfigReq) (*ReloadConfigResp, error) {
+	out := new(ReloadConfigResp)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetAll. nil responses are not supported"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
-	respBytes, err := marshaler.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
-		return
-	}
+	return out, nil
+}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/json")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
+// ==================
+// Config JSON Client
+// ==================
 
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+type configJSONClient struct {
+	client      HTTPClient
+	urls        [3]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
 }
 
-func (s *sportsmatrixServer) serveSetAllProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetAll")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+// NewConfigJSONClient creates a JSON client that implements the Config interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewConfigJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Config {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
 	}
 
-	buf, err := ioutil.ReadAll(req.Body)
-	if err != nil {
-		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
-		return
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
 	}
-	reqContent := new(SetAllReq)
-	if err = proto.Unmarshal(buf, reqContent); err != nil {
-		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
-		return
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
 	}
 
-	handler := s.Sportsmatrix.SetAll
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *SetAllReq) (*google_protobuf.Empty, error) {
-			resp, err := s.interceptor(
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "matrix.v1", "Config")
+	urls := [3]string{
+		serviceURL + "GetConfig",
+		serviceURL + "GetConfigSchema",
+		serviceURL + "UpdateConfig",
+	}
+
+	return &configJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *configJSONClient) GetConfig(ctx context.Context, in *google_protobuf.Empty) (*ConfigResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Config")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfig")
+	caller := c.callGetConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*ConfigResp, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SetAllReq)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SetAllReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.SetAll(ctx, typedReq)
+					return c.callGetConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*ConfigResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfigResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *google_protobuf.Empty
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
+func (c *configJSONClient) callGetConfig(ctx context.Context, in *google_protobuf.Empty) (*ConfigResp, error) {
+	out := new(ConfigResp)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetAll. nil responses are not supported"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	respBytes, err := proto.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/protobuf")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+	return out, nil
 }
 
-func (s *sportsmatrixServer) serveJump(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	header := req.Header.Get("Content-Type")
-	i := strings.Index(header, ";")
-	if i == -1 {
-		i = len(header)
-	}
-	switch strings.TrimSpace(strings.ToLower(header[:i])) {
-	case "application/json":
-		s.serveJumpJSON(ctx, resp, req)
-	case "application/protobuf":
-		s.serveJumpProtobuf(ctx, resp, req)
-	default:
-		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
-		twerr := badRouteError(msg, req.Method, req.URL.Path)
-		s.writeError(ctx, resp, twerr)
+func (c *configJSONClient) GetConfigSchema(ctx context.Context, in *google_protobuf.Empty) (*ConfigSchemaResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Config")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfigSchema")
+	caller := c.callGetConfigSchema
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*ConfigSchemaResp, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetConfigSchema(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConfigSchemaResp)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfigSchemaResp) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
 }
 
-func (s *sportsmatrixServer) serveJumpJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "Jump")
-	ctx, err = callRequestRouted(ctx, s.hooks)
+func (c *configJSONClient) callGetConfigSchema(ctx context.Context, in *google_protobuf.Empty) (*ConfigSchemaResp, error) {
+	out := new(ConfigSchemaResp)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-
-	d := json.NewDecoder(req.Body)
-	rawReqBody := json.RawMessage{}
-	if err := d.Decode(&rawReqBody); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
-	reqContent := new(JumpReq)
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	handler := s.Sportsmatrix.Jump
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *JumpReq) (*google_protobuf.Empty, error) {
-			resp, err := s.interceptor(
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *configJSONClient) UpdateConfig(ctx context.Context, in *UpdateConfigReq) (*ReloadConfigResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Config")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateConfig")
+	caller := c.callUpdateConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateConfigReq) (*ReloadConfigResp, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*JumpReq)
+					typedReq, ok := req.(*UpdateConfigReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*JumpReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateConfigReq) when calling interceptor")
 					}
-					return s.Sportsmatrix.Jump(ctx, typedReq)
+					return c.callUpdateConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*ReloadConfigResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ReloadConfigResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *google_protobuf.Empty
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
+func (c *configJSONClient) callUpdateConfig(ctx context.Context, in *UpdateConfigReq) (*ReloadConfigResp, error) {
+	out := new(ReloadConfigResp)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling Jump. nil responses are not supported"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
-	respBytes, err := marshaler.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
-		return
-	}
+	return out, nil
+}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/json")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
+// =====================
+// Config Server Handler
+// =====================
 
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+type configServer struct {
+	Config
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
 }
 
-func (s *sportsmatrixServer) serveJumpProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "Jump")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+// NewConfigServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewConfigServer(svc Config, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
 	}
 
-	buf, err := ioutil.ReadAll(req.Body)
-	if err != nil {
-		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+	return &configServer{
+		Config:           svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *configServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *configServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
 		return
 	}
-	reqContent := new(JumpReq)
-	if err = proto.Unmarshal(buf, reqContent); err != nil {
-		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
 		return
 	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
 
-	handler := s.Sportsmatrix.Jump
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *JumpReq) (*google_protobuf.Empty, error) {
-			resp, err := s.interceptor(
-				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*JumpReq)
-					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*JumpReq) when calling interceptor")
-					}
-					return s.Sportsmatrix.Jump(ctx, typedReq)
-				},
-			)(ctx, req)
-			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
-				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
-				}
-				return typedResp, err
-			}
-			return nil, err
-		}
-	}
+// ConfigPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const ConfigPathPrefix = "/twirp/matrix.v1.Config/"
 
-	// Call service method
-	var respContent *google_protobuf.Empty
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
+func (s *configServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "matrix.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "Config")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
 
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling Jump. nil responses are not supported"))
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
 		return
 	}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	respBytes, err := proto.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "matrix.v1.Config" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
 		return
 	}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/protobuf")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
+	switch method {
+	case "GetConfig":
+		s.serveGetConfig(ctx, resp, req)
+		return
+	case "GetConfigSchema":
+		s.serveGetConfigSchema(ctx, resp, req)
+		return
+	case "UpdateConfig":
+		s.serveUpdateConfig(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
 	}
-	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveNextBoard(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServer) serveGetConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2488,9 +6051,9 @@ func (s *sportsmatrixServer) serveNextBoard(ctx context.Context, resp http.Respo
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveNextBoardJSON(ctx, resp, req)
+		s.serveGetConfigJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveNextBoardProtobuf(ctx, resp, req)
+		s.serveGetConfigProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2498,9 +6061,9 @@ func (s *sportsmatrixServer) serveNextBoard(ctx context.Context, resp http.Respo
 	}
 }
 
-func (s *sportsmatrixServer) serveNextBoardJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServer) serveGetConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "NextBoard")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfig")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2520,22 +6083,22 @@ func (s *sportsmatrixServer) serveNextBoardJSON(ctx context.Context, resp http.R
 		return
 	}
 
-	handler := s.Sportsmatrix.NextBoard
+	handler := s.Config.GetConfig
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*ConfigResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.NextBoard(ctx, typedReq)
+					return s.Config.GetConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*ConfigResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfigResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2544,7 +6107,7 @@ func (s *sportsmatrixServer) serveNextBoardJSON(ctx context.Context, resp http.R
 	}
 
 	// Call service method
-	var respContent *google_protobuf.Empty
+	var respContent *ConfigResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2555,7 +6118,7 @@ func (s *sportsmatrixServer) serveNextBoardJSON(ctx context.Context, resp http.R
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling NextBoard. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfigResp and nil error while calling GetConfig. nil responses are not supported"))
 		return
 	}
 
@@ -2581,9 +6144,9 @@ func (s *sportsmatrixServer) serveNextBoardJSON(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveNextBoardProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServer) serveGetConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "NextBoard")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfig")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2601,22 +6164,22 @@ func (s *sportsmatrixServer) serveNextBoardProtobuf(ctx context.Context, resp ht
 		return
 	}
 
-	handler := s.Sportsmatrix.NextBoard
+	handler := s.Config.GetConfig
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*ConfigResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.NextBoard(ctx, typedReq)
+					return s.Config.GetConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*ConfigResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfigResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2625,7 +6188,7 @@ func (s *sportsmatrixServer) serveNextBoardProtobuf(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *google_protobuf.Empty
+	var respContent *ConfigResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2636,7 +6199,7 @@ func (s *sportsmatrixServer) serveNextBoardProtobuf(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling NextBoard. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfigResp and nil error while calling GetConfig. nil responses are not supported"))
 		return
 	}
 
@@ -2660,7 +6223,7 @@ func (s *sportsmatrixServer) serveNextBoardProtobuf(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveRestartService(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServer) serveGetConfigSchema(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2668,9 +6231,9 @@ func (s *sportsmatrixServer) serveRestartService(ctx context.Context, resp http.
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveRestartServiceJSON(ctx, resp, req)
+		s.serveGetConfigSchemaJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveRestartServiceProtobuf(ctx, resp, req)
+		s.serveGetConfigSchemaProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2678,9 +6241,9 @@ func (s *sportsmatrixServer) serveRestartService(ctx context.Context, resp http.
 	}
 }
 
-func (s *sportsmatrixServer) serveRestartServiceJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServer) serveGetConfigSchemaJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RestartService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfigSchema")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2700,22 +6263,22 @@ func (s *sportsmatrixServer) serveRestartServiceJSON(ctx context.Context, resp h
 		return
 	}
 
-	handler := s.Sportsmatrix.RestartService
+	handler := s.Config.GetConfigSchema
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*ConfigSchemaResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.RestartService(ctx, typedReq)
+					return s.Config.GetConfigSchema(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*ConfigSchemaResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfigSchemaResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2724,7 +6287,7 @@ func (s *sportsmatrixServer) serveRestartServiceJSON(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *google_protobuf.Empty
+	var respContent *ConfigSchemaResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2735,7 +6298,7 @@ func (s *sportsmatrixServer) serveRestartServiceJSON(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RestartService. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfigSchemaResp and nil error while calling GetConfigSchema. nil responses are not supported"))
 		return
 	}
 
@@ -2761,9 +6324,9 @@ func (s *sportsmatrixServer) serveRestartServiceJSON(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveRestartServiceProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServer) serveGetConfigSchemaProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RestartService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetConfigSchema")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2781,22 +6344,22 @@ func (s *sportsmatrixServer) serveRestartServiceProtobuf(ctx context.Context, re
 		return
 	}
 
-	handler := s.Sportsmatrix.RestartService
+	handler := s.Config.GetConfigSchema
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*ConfigSchemaResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return s.Sportsmatrix.RestartService(ctx, typedReq)
+					return s.Config.GetConfigSchema(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*ConfigSchemaResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfigSchemaResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2805,7 +6368,7 @@ func (s *sportsmatrixServer) serveRestartServiceProtobuf(ctx context.Context, re
 	}
 
 	// Call service method
-	var respContent *google_protobuf.Empty
+	var respContent *ConfigSchemaResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2816,7 +6379,7 @@ func (s *sportsmatrixServer) serveRestartServiceProtobuf(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RestartService. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfigSchemaResp and nil error while calling GetConfigSchema. nil responses are not supported"))
 		return
 	}
 
@@ -2840,7 +6403,7 @@ func (s *sportsmatrixServer) serveRestartServiceProtobuf(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSetLiveOnly(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServer) serveUpdateConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2848,9 +6411,9 @@ func (s *sportsmatrixServer) serveSetLiveOnly(ctx context.Context, resp http.Res
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSetLiveOnlyJSON(ctx, resp, req)
+		s.serveUpdateConfigJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSetLiveOnlyProtobuf(ctx, resp, req)
+		s.serveUpdateConfigProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2858,9 +6421,9 @@ func (s *sportsmatrixServer) serveSetLiveOnly(ctx context.Context, resp http.Res
 	}
 }
 
-func (s *sportsmatrixServer) serveSetLiveOnlyJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServer) serveUpdateConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetLiveOnly")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateConfig")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2873,29 +6436,29 @@ func (s *sportsmatrixServer) serveSetLiveOnlyJSON(ctx context.Context, resp http
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(LiveOnlyReq)
+	reqContent := new(UpdateConfigReq)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Sportsmatrix.SetLiveOnly
+	handler := s.Config.UpdateConfig
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *LiveOnlyReq) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *UpdateConfigReq) (*ReloadConfigResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*LiveOnlyReq)
+					typedReq, ok := req.(*UpdateConfigReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*LiveOnlyReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateConfigReq) when calling interceptor")
 					}
-					return s.Sportsmatrix.SetLiveOnly(ctx, typedReq)
+					return s.Config.UpdateConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*ReloadConfigResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ReloadConfigResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2904,7 +6467,7 @@ func (s *sportsmatrixServer) serveSetLiveOnlyJSON(ctx context.Context, resp http
 	}
 
 	// Call service method
-	var respContent *google_protobuf.Empty
+	var respContent *ReloadConfigResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2915,7 +6478,7 @@ func (s *sportsmatrixServer) serveSetLiveOnlyJSON(ctx context.Context, resp http
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetLiveOnly. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReloadConfigResp and nil error while calling UpdateConfig. nil responses are not supported"))
 		return
 	}
 
@@ -2941,9 +6504,9 @@ func (s *sportsmatrixServer) serveSetLiveOnlyJSON(ctx context.Context, resp http
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) serveSetLiveOnlyProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *configServer) serveUpdateConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetLiveOnly")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateConfig")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2955,28 +6518,28 @@ func (s *sportsmatrixServer) serveSetLiveOnlyProtobuf(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(LiveOnlyReq)
+	reqContent := new(UpdateConfigReq)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Sportsmatrix.SetLiveOnly
+	handler := s.Config.UpdateConfig
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *LiveOnlyReq) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *UpdateConfigReq) (*ReloadConfigResp, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*LiveOnlyReq)
+					typedReq, ok := req.(*UpdateConfigReq)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*LiveOnlyReq) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateConfigReq) when calling interceptor")
 					}
-					return s.Sportsmatrix.SetLiveOnly(ctx, typedReq)
+					return s.Config.UpdateConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*ReloadConfigResp)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ReloadConfigResp) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2985,7 +6548,7 @@ func (s *sportsmatrixServer) serveSetLiveOnlyProtobuf(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *google_protobuf.Empty
+	var respContent *ReloadConfigResp
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2996,7 +6559,7 @@ func (s *sportsmatrixServer) serveSetLiveOnlyProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetLiveOnly. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReloadConfigResp and nil error while calling UpdateConfig. nil responses are not supported"))
 		return
 	}
 
@@ -3020,19 +6583,19 @@ func (s *sportsmatrixServer) serveSetLiveOnlyProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *sportsmatrixServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 0
+func (s *configServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 1
 }
 
-func (s *sportsmatrixServer) ProtocGenTwirpVersion() string {
+func (s *configServer) ProtocGenTwirpVersion() string {
 	return "v8.1.1"
 }
 
 // PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
 // that is everything in a Twirp route except for the <Method>. This can be used for routing,
 // for example to identify the requests that are targeted to this service in a mux.
-func (s *sportsmatrixServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "matrix.v1", "Sportsmatrix")
+func (s *configServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "matrix.v1", "Config")
 }
 
 // =====
@@ -3598,32 +7161,69 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xd1, 0x6a, 0xd4, 0x40,
-	0x14, 0xa5, 0x5a, 0x77, 0x37, 0x37, 0x52, 0x71, 0x28, 0x65, 0xe9, 0x3e, 0xac, 0x04, 0xa4, 0xe2,
-	0x43, 0x82, 0x15, 0x2a, 0x55, 0x04, 0x2d, 0x88, 0x20, 0xe2, 0x42, 0x02, 0x3e, 0xf8, 0xb2, 0x64,
-	0x92, 0xbb, 0xeb, 0xc0, 0x64, 0x26, 0xce, 0x4c, 0x62, 0xf3, 0xaf, 0x7e, 0x8c, 0x24, 0x99, 0x91,
-	0x11, 0x4d, 0x61, 0x1f, 0xcf, 0xb9, 0xe7, 0x64, 0x2e, 0xe7, 0xe4, 0xc2, 0x5a, 0xd7, 0x52, 0x19,
-	0x5d, 0xe5, 0x46, 0xb1, 0xdb, 0xc4, 0x07, 0x71, 0xad, 0xa4, 0x91, 0x24, 0xb0, 0xa8, 0x7d, 0x71,
-	0xbe, 0xda, 0x4b, 0xb9, 0xe7, 0x98, 0x0c, 0x03, 0xda, 0xec, 0x12, 0xac, 0x6a, 0xd3, 0x8d, 0xba,
-	0xe8, 0x02, 0xc2, 0xaf, 0xa8, 0x34, 0x93, 0x22, 0x45, 0x5d, 0x93, 0x25, 0xcc, 0xdb, 0x11, 0x2e,
-	0x8f, 0x9e, 0x1c, 0x3d, 0x0b, 0x52, 0x07, 0x23, 0x09, 0xb3, 0xcc, 0xe4, 0xa6, 0xd1, 0x64, 0x05,
-	0x81, 0x2e, 0x14, 0xa2, 0xd8, 0x5a, 0xd5, 0x22, 0x5d, 0x8c, 0xc4, 0x46, 0x90, 0x35, 0x84, 0x3f,
-	0x91, 0x52, 0x99, 0xab, 0xb2, 0x1f, 0xdf, 0x1b, 0xc6, 0xe0, 0xa8, 0x8d, 0x20, 0x17, 0xf0, 0xa8,
-	0x90, 0x15, 0x65, 0x02, 0xcb, 0xad, 0x2e, 0x94, 0xe4, 0x7c, 0x79, 0x7f, 0x10, 0x9d, 0x38, 0x3a,
-	0x1b, 0xd8, 0xe8, 0x29, 0x04, 0x19, 0x9a, 0xf7, 0x9c, 0xa7, 0xf8, 0xa3, 0xdf, 0x0b, 0x45, 0x4e,
-	0x39, 0x96, 0xf6, 0x45, 0x07, 0xa3, 0x35, 0xcc, 0x3f, 0x35, 0x55, 0xdd, 0x8b, 0x4e, 0xe1, 0xc1,
-	0xf0, 0x8a, 0x5d, 0x7d, 0x04, 0xd1, 0x73, 0x08, 0x3f, 0xb3, 0x16, 0x37, 0x82, 0x77, 0xbd, 0x68,
-	0x05, 0x01, 0x67, 0x2d, 0x6e, 0xa5, 0xe0, 0x9d, 0xdb, 0x9e, 0xdb, 0xf9, 0xe5, 0xaf, 0x63, 0x78,
-	0x98, 0x79, 0x61, 0x92, 0x6b, 0x98, 0xdb, 0x78, 0xc8, 0x59, 0x3c, 0xe6, 0x18, 0xbb, 0x1c, 0xe3,
-	0x0f, 0x7d, 0x8e, 0xe7, 0x67, 0xf1, 0x9f, 0xa8, 0x63, 0x3f, 0xca, 0xd7, 0xb0, 0xc8, 0x5c, 0x2a,
-	0xd3, 0xde, 0xff, 0xf2, 0xe4, 0x0d, 0x04, 0xd6, 0xbb, 0xdb, 0x1d, 0x6c, 0xbe, 0x82, 0xe0, 0x23,
-	0x1a, 0x5b, 0xd6, 0x94, 0xf9, 0xb1, 0xb7, 0xb5, 0x95, 0x5e, 0x0d, 0x81, 0x5b, 0xf0, 0xef, 0xfc,
-	0x8e, 0xf7, 0x66, 0x63, 0x51, 0xe4, 0xd4, 0x37, 0xb9, 0xee, 0x26, 0x7d, 0x97, 0x70, 0xdc, 0x37,
-	0x47, 0x88, 0xe7, 0xb2, 0x55, 0xde, 0x15, 0xcc, 0x17, 0xbc, 0x35, 0x37, 0x7d, 0xb3, 0x07, 0x07,
-	0xf3, 0x0e, 0x4e, 0x52, 0xd4, 0x26, 0x57, 0x26, 0x43, 0xd5, 0xb2, 0x02, 0x0f, 0xfe, 0xc2, 0x5b,
-	0x08, 0x33, 0x34, 0xee, 0x77, 0x22, 0x7e, 0xf5, 0xde, 0x3f, 0x36, 0x65, 0xbf, 0xb9, 0xfe, 0xf6,
-	0x6a, 0xcf, 0xcc, 0xf7, 0x86, 0xc6, 0x85, 0xac, 0x12, 0x25, 0x29, 0xed, 0xca, 0x0e, 0x95, 0xbd,
-	0xdf, 0x84, 0x09, 0x83, 0x4a, 0xe4, 0x7c, 0xbc, 0xd4, 0xbf, 0xae, 0x9a, 0xce, 0x06, 0xee, 0xe5,
-	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0xbf, 0x16, 0x0b, 0xf9, 0x03, 0x00, 0x00,
+	// 1015 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdb, 0x72, 0xe3, 0x44,
+	0x13, 0x2e, 0xe7, 0xe0, 0x44, 0x6d, 0x3b, 0xc9, 0x3f, 0x7f, 0x48, 0x89, 0xa4, 0x20, 0x59, 0x71,
+	0xd8, 0xec, 0x5e, 0xd8, 0x10, 0x8a, 0xa5, 0xf6, 0x54, 0x90, 0x6c, 0x2d, 0xa1, 0xa8, 0x85, 0x50,
+	0x12, 0x70, 0xc1, 0x8d, 0x4a, 0x96, 0x3a, 0xce, 0x14, 0x23, 0x8d, 0x32, 0x33, 0xf6, 0xae, 0x1f,
+	0x83, 0x6b, 0x9e, 0x87, 0x47, 0xe0, 0x7d, 0xa8, 0x39, 0xc8, 0x19, 0x93, 0x38, 0x21, 0x77, 0xea,
+	0x6f, 0xbe, 0xee, 0x99, 0xf9, 0xba, 0xf5, 0x49, 0xb0, 0x2f, 0x6b, 0x2e, 0x94, 0x2c, 0x33, 0x25,
+	0xe8, 0xbb, 0x81, 0x1f, 0xf4, 0x6b, 0xc1, 0x15, 0x27, 0x81, 0x8b, 0x26, 0x9f, 0xef, 0xee, 0x8d,
+	0x38, 0x1f, 0x31, 0x1c, 0x98, 0x85, 0xe1, 0xf8, 0x7c, 0x80, 0x65, 0xad, 0xa6, 0x96, 0x17, 0x3d,
+	0x84, 0xce, 0xaf, 0x28, 0x24, 0xe5, 0x55, 0x8c, 0xb2, 0x26, 0x21, 0xac, 0x4d, 0x6c, 0x18, 0xb6,
+	0x0e, 0x5a, 0x87, 0x41, 0xdc, 0x84, 0xd1, 0x5f, 0x2d, 0x68, 0x27, 0x2a, 0x53, 0x63, 0x49, 0xf6,
+	0x20, 0x90, 0xb9, 0x40, 0xac, 0x52, 0x47, 0x5b, 0x8f, 0xd7, 0x2d, 0x70, 0x56, 0x91, 0x7d, 0xe8,
+	0xbc, 0xc5, 0xe1, 0x90, 0x67, 0xa2, 0xd0, 0xcb, 0x4b, 0x66, 0x19, 0x1a, 0xe8, 0xac, 0x22, 0x0f,
+	0x61, 0x33, 0xe7, 0xe5, 0x90, 0x56, 0x58, 0xa4, 0x32, 0x17, 0x9c, 0xb1, 0x70, 0xd9, 0x90, 0x36,
+	0x1a, 0x38, 0x31, 0x28, 0x19, 0xc0, 0xff, 0x51, 0x2a, 0x5a, 0x66, 0x0a, 0x8b, 0xb4, 0xa4, 0x8c,
+	0xd1, 0xac, 0xac, 0x65, 0xb8, 0x72, 0xd0, 0x3a, 0x6c, 0xc5, 0x64, 0xb6, 0xf4, 0x43, 0xb3, 0x42,
+	0x3e, 0x82, 0x5e, 0xcd, 0xdf, 0xa2, 0x48, 0x19, 0x2d, 0xa9, 0xc2, 0x22, 0x5c, 0x35, 0x75, 0xbb,
+	0x06, 0x7c, 0x63, 0xb1, 0xe8, 0x13, 0x08, 0x12, 0x54, 0xc7, 0x8c, 0xc5, 0x78, 0xa9, 0xaf, 0x8b,
+	0x55, 0x36, 0x64, 0x58, 0xb8, 0x7b, 0x34, 0x61, 0xb4, 0x0f, 0x6b, 0xdf, 0x8f, 0xcb, 0x5a, 0x93,
+	0xb6, 0x61, 0xd5, 0x9c, 0xdd, 0x29, 0x62, 0x83, 0xe8, 0x31, 0x74, 0xde, 0xd0, 0x09, 0x9e, 0x55,
+	0x6c, 0xaa, 0x49, 0x7b, 0x10, 0x30, 0x3a, 0xc1, 0x94, 0x57, 0x6c, 0xda, 0x68, 0xc2, 0xdc, 0x7a,
+	0xf4, 0x00, 0x3a, 0x3f, 0xb1, 0x6c, 0xca, 0xa8, 0x54, 0x9a, 0x4b, 0x60, 0xa5, 0xca, 0x4a, 0x74,
+	0xf5, 0xcc, 0x73, 0xf4, 0x12, 0x7a, 0x0d, 0x45, 0x9a, 0x4e, 0x6c, 0xc3, 0xaa, 0x5e, 0x90, 0x61,
+	0xeb, 0x60, 0x59, 0xef, 0x6a, 0x02, 0xb2, 0x03, 0xed, 0x2c, 0x57, 0x74, 0x82, 0x46, 0xd8, 0x20,
+	0x76, 0x51, 0xc4, 0x61, 0x2b, 0x46, 0xc6, 0xb3, 0xe2, 0x15, 0xaf, 0xce, 0xe9, 0xa8, 0xe9, 0x65,
+	0x56, 0xd7, 0x8c, 0x62, 0xe1, 0x6a, 0x34, 0x21, 0x79, 0x04, 0x5b, 0x02, 0xa5, 0xca, 0x84, 0x4a,
+	0x05, 0x5e, 0x8e, 0xa9, 0xc0, 0x22, 0x5c, 0x32, 0x94, 0x4d, 0x87, 0xc7, 0x0e, 0xd6, 0x1b, 0xa2,
+	0x10, 0x5c, 0xc8, 0x70, 0xd9, 0x10, 0x5c, 0x14, 0x3d, 0x87, 0x20, 0xc6, 0x9c, 0x8b, 0xc2, 0xc9,
+	0x28, 0x31, 0xe7, 0x55, 0x21, 0xcd, 0x9d, 0x56, 0xe3, 0x26, 0xd4, 0xb7, 0x90, 0x79, 0xc6, 0xec,
+	0x71, 0x57, 0x63, 0x1b, 0x44, 0x07, 0x00, 0x4d, 0xb2, 0xac, 0xb5, 0x1c, 0xe7, 0x94, 0xcd, 0xe4,
+	0xd0, 0xcf, 0xd1, 0x1f, 0x4b, 0xd0, 0x39, 0xd1, 0x3a, 0x7f, 0x87, 0x19, 0x53, 0x17, 0x37, 0x49,
+	0xe6, 0x37, 0x6f, 0x69, 0xae, 0x79, 0x7a, 0x06, 0x59, 0x26, 0xf5, 0xe5, 0xaa, 0x02, 0x85, 0x19,
+	0xaf, 0x20, 0x06, 0x0d, 0xc5, 0x06, 0x21, 0x1f, 0x80, 0x89, 0x52, 0x73, 0x19, 0x33, 0x51, 0x41,
+	0x1c, 0x68, 0xe4, 0xb5, 0x06, 0xc8, 0xa7, 0xb0, 0x79, 0xb5, 0x9c, 0x2a, 0x5a, 0xa2, 0x19, 0xa5,
+	0x20, 0xee, 0xcd, 0x38, 0x3f, 0xd3, 0x12, 0xc9, 0x03, 0xe8, 0x16, 0x99, 0xca, 0xd2, 0x71, 0x5d,
+	0xe8, 0x49, 0x0c, 0xdb, 0x86, 0xd4, 0xd1, 0xd8, 0x2f, 0x16, 0x22, 0x87, 0xb0, 0x65, 0x28, 0xd9,
+	0x08, 0xd3, 0x46, 0xa3, 0x35, 0x33, 0xc1, 0x1b, 0x1a, 0x3f, 0x1e, 0x61, 0xe2, 0xa4, 0xda, 0x87,
+	0x8e, 0xfc, 0x9d, 0xd6, 0xa9, 0xc0, 0x4c, 0xf2, 0x2a, 0x5c, 0xb7, 0x87, 0xd6, 0x50, 0x6c, 0x90,
+	0xe8, 0x05, 0x80, 0x55, 0xc3, 0xa8, 0xd6, 0x87, 0xb6, 0x19, 0x44, 0x3b, 0x20, 0x9d, 0xa3, 0x9d,
+	0xfe, 0xec, 0x8d, 0xef, 0x7b, 0xca, 0xc5, 0x8e, 0x15, 0x0d, 0xa0, 0x77, 0x22, 0xe8, 0xe8, 0x42,
+	0x55, 0x28, 0xa5, 0x6e, 0xda, 0x87, 0x00, 0xc3, 0x19, 0xe0, 0xfa, 0xe6, 0x21, 0xd1, 0x67, 0xb0,
+	0xe1, 0x27, 0xc8, 0xfa, 0xce, 0x8c, 0x8f, 0x01, 0xbc, 0xf1, 0xdb, 0x81, 0x76, 0x6e, 0x22, 0xd7,
+	0x34, 0x17, 0x45, 0x8f, 0x61, 0xcb, 0xb2, 0x92, 0xfc, 0x02, 0xcb, 0xac, 0xe1, 0x4a, 0x13, 0x35,
+	0x5c, 0x1b, 0x45, 0x8f, 0x60, 0xd3, 0x0a, 0xd9, 0xd4, 0xbd, 0x5c, 0x54, 0xf6, 0xe8, 0xcf, 0x00,
+	0xba, 0x89, 0xe7, 0x83, 0xe4, 0x29, 0xac, 0x39, 0x67, 0x23, 0x3b, 0x7d, 0x6b, 0x81, 0xfd, 0xc6,
+	0x02, 0xfb, 0xaf, 0xb5, 0x05, 0xee, 0xfa, 0x9a, 0xf9, 0x2e, 0xf8, 0x0c, 0xd6, 0x93, 0xc6, 0xcf,
+	0x16, 0xe7, 0xde, 0x88, 0x93, 0xe7, 0x10, 0xb8, 0xdc, 0xf3, 0xf3, 0x7b, 0x27, 0x3f, 0x81, 0xe0,
+	0x14, 0x95, 0xb3, 0xd9, 0x45, 0xc9, 0xff, 0xf3, 0x4e, 0xed, 0xa8, 0x4f, 0x8c, 0xa9, 0xb9, 0xe0,
+	0xfa, 0xfa, 0x2d, 0xfb, 0xb5, 0xad, 0x19, 0x92, 0x6d, 0x3f, 0xa9, 0xf1, 0xc7, 0x85, 0x79, 0x47,
+	0xb0, 0xa2, 0xdd, 0x91, 0x10, 0x2f, 0xcb, 0xd9, 0xe5, 0x6d, 0xc2, 0xfc, 0x88, 0xef, 0x94, 0x99,
+	0xcd, 0x7b, 0x0b, 0xf3, 0x0d, 0x6c, 0xc4, 0xd6, 0x99, 0x12, 0x14, 0x13, 0x9a, 0xe3, 0xbd, 0x2b,
+	0xbc, 0x84, 0x4e, 0x82, 0xaa, 0xb1, 0x6c, 0xe2, 0xb7, 0xde, 0xf3, 0xf1, 0x3b, 0xd2, 0x1b, 0x8b,
+	0x9e, 0x4b, 0xf7, 0xac, 0xfd, 0x96, 0xf3, 0x77, 0x4f, 0xaf, 0xd2, 0x17, 0xf7, 0x36, 0xbc, 0xa1,
+	0xae, 0x7d, 0xf9, 0x5e, 0x41, 0xd7, 0x77, 0xf8, 0x85, 0x15, 0xf6, 0xbc, 0x0a, 0xd7, 0x3e, 0x09,
+	0x2f, 0xb4, 0xf1, 0x4a, 0x3b, 0x29, 0xf7, 0x97, 0xf0, 0x4b, 0x68, 0x5b, 0xdb, 0x9e, 0x9b, 0x96,
+	0xd9, 0x67, 0x60, 0xf7, 0xbd, 0x1b, 0x50, 0x59, 0x93, 0xaf, 0xa1, 0x97, 0x28, 0x5e, 0x5b, 0x84,
+	0x56, 0xa3, 0x7b, 0xef, 0xfb, 0xcc, 0xbc, 0x15, 0xee, 0x4b, 0xb0, 0x28, 0xd9, 0xdf, 0xdc, 0xb3,
+	0xc9, 0x63, 0xe8, 0x25, 0xa8, 0xae, 0x8c, 0x8c, 0xf8, 0x0a, 0xcf, 0x19, 0xe2, 0xc2, 0xed, 0x4f,
+	0xa0, 0x77, 0x3a, 0x57, 0x62, 0xd1, 0x11, 0xde, 0x5f, 0x50, 0x5a, 0xd6, 0x47, 0x7f, 0xb7, 0xa0,
+	0xed, 0x1a, 0x67, 0x6f, 0x73, 0x47, 0x17, 0xfd, 0xdb, 0x78, 0xfd, 0xfb, 0x16, 0x36, 0x67, 0xb9,
+	0xd6, 0x3e, 0xff, 0xd3, 0x1c, 0x5c, 0xf3, 0xdb, 0x53, 0xe8, 0xfa, 0xbe, 0x4a, 0x76, 0x3d, 0xf2,
+	0xbf, 0x0c, 0xf7, 0xd6, 0x81, 0x3a, 0x79, 0xfa, 0xdb, 0x57, 0x23, 0xaa, 0x2e, 0xc6, 0xc3, 0x7e,
+	0xce, 0xcb, 0x81, 0xe0, 0xc3, 0xe1, 0xb4, 0x98, 0xa2, 0x70, 0x7f, 0xa4, 0x03, 0x5a, 0x29, 0x14,
+	0x55, 0xc6, 0xec, 0xbf, 0xe7, 0xdc, 0x7f, 0xea, 0xb0, 0x6d, 0xb0, 0x2f, 0xfe, 0x19, 0x00, 0x90,
+	0x07, 0x6f, 0xa0, 0xcb, 0x0a, 0x00, 0x00,
 }
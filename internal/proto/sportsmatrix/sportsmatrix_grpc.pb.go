@@ -33,6 +33,15 @@ type SportsmatrixClient interface {
 	NextBoard(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 	RestartService(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 	SetLiveOnly(ctx context.Context, in *LiveOnlyReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	SetPlaylist(ctx context.Context, in *PlaylistReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetPlaylists(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PlaylistsResp, error)
+	ReloadConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ReloadConfigResp, error)
+	ResetState(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	Record(ctx context.Context, in *RecordReq, opts ...grpc.CallOption) (*RecordResp, error)
+	StopRecording(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetHealth(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HealthResp, error)
+	SetBrightness(ctx context.Context, in *BrightnessReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetBrightness(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BrightnessResp, error)
 }
 
 type sportsmatrixClient struct {
@@ -133,6 +142,87 @@ func (c *sportsmatrixClient) SetLiveOnly(ctx context.Context, in *LiveOnlyReq, o
 	return out, nil
 }
 
+func (c *sportsmatrixClient) SetPlaylist(ctx context.Context, in *PlaylistReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/matrix.v1.Sportsmatrix/SetPlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsmatrixClient) GetPlaylists(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PlaylistsResp, error) {
+	out := new(PlaylistsResp)
+	err := c.cc.Invoke(ctx, "/matrix.v1.Sportsmatrix/GetPlaylists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsmatrixClient) ReloadConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ReloadConfigResp, error) {
+	out := new(ReloadConfigResp)
+	err := c.cc.Invoke(ctx, "/matrix.v1.Sportsmatrix/ReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsmatrixClient) ResetState(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/matrix.v1.Sportsmatrix/ResetState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsmatrixClient) Record(ctx context.Context, in *RecordReq, opts ...grpc.CallOption) (*RecordResp, error) {
+	out := new(RecordResp)
+	err := c.cc.Invoke(ctx, "/matrix.v1.Sportsmatrix/Record", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsmatrixClient) StopRecording(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/matrix.v1.Sportsmatrix/StopRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsmatrixClient) GetHealth(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HealthResp, error) {
+	out := new(HealthResp)
+	err := c.cc.Invoke(ctx, "/matrix.v1.Sportsmatrix/GetHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsmatrixClient) SetBrightness(ctx context.Context, in *BrightnessReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/matrix.v1.Sportsmatrix/SetBrightness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sportsmatrixClient) GetBrightness(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BrightnessResp, error) {
+	out := new(BrightnessResp)
+	err := c.cc.Invoke(ctx, "/matrix.v1.Sportsmatrix/GetBrightness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SportsmatrixServer is the server API for Sportsmatrix service.
 // All implementations must embed UnimplementedSportsmatrixServer
 // for forward compatibility
@@ -147,6 +237,15 @@ type SportsmatrixServer interface {
 	NextBoard(context.Context, *empty.Empty) (*empty.Empty, error)
 	RestartService(context.Context, *empty.Empty) (*empty.Empty, error)
 	SetLiveOnly(context.Context, *LiveOnlyReq) (*empty.Empty, error)
+	SetPlaylist(context.Context, *PlaylistReq) (*empty.Empty, error)
+	GetPlaylists(context.Context, *empty.Empty) (*PlaylistsResp, error)
+	ReloadConfig(context.Context, *empty.Empty) (*ReloadConfigResp, error)
+	ResetState(context.Context, *empty.Empty) (*empty.Empty, error)
+	Record(context.Context, *RecordReq) (*RecordResp, error)
+	StopRecording(context.Context, *empty.Empty) (*empty.Empty, error)
+	GetHealth(context.Context, *empty.Empty) (*HealthResp, error)
+	SetBrightness(context.Context, *BrightnessReq) (*empty.Empty, error)
+	GetBrightness(context.Context, *empty.Empty) (*BrightnessResp, error)
 	mustEmbedUnimplementedSportsmatrixServer()
 }
 
@@ -184,6 +283,33 @@ func (UnimplementedSportsmatrixServer) RestartService(context.Context, *empty.Em
 func (UnimplementedSportsmatrixServer) SetLiveOnly(context.Context, *LiveOnlyReq) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetLiveOnly not implemented")
 }
+func (UnimplementedSportsmatrixServer) SetPlaylist(context.Context, *PlaylistReq) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlaylist not implemented")
+}
+func (UnimplementedSportsmatrixServer) GetPlaylists(context.Context, *empty.Empty) (*PlaylistsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaylists not implemented")
+}
+func (UnimplementedSportsmatrixServer) ReloadConfig(context.Context, *empty.Empty) (*ReloadConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (UnimplementedSportsmatrixServer) ResetState(context.Context, *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetState not implemented")
+}
+func (UnimplementedSportsmatrixServer) Record(context.Context, *RecordReq) (*RecordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Record not implemented")
+}
+func (UnimplementedSportsmatrixServer) StopRecording(context.Context, *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRecording not implemented")
+}
+func (UnimplementedSportsmatrixServer) GetHealth(context.Context, *empty.Empty) (*HealthResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealth not implemented")
+}
+func (UnimplementedSportsmatrixServer) SetBrightness(context.Context, *BrightnessReq) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBrightness not implemented")
+}
+func (UnimplementedSportsmatrixServer) GetBrightness(context.Context, *empty.Empty) (*BrightnessResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBrightness not implemented")
+}
 func (UnimplementedSportsmatrixServer) mustEmbedUnimplementedSportsmatrixServer() {}
 
 // UnsafeSportsmatrixServer may be embedded to opt out of forward compatibility for this service.
@@ -377,6 +503,168 @@ func _Sportsmatrix_SetLiveOnly_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sportsmatrix_SetPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsmatrixServer).SetPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matrix.v1.Sportsmatrix/SetPlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsmatrixServer).SetPlaylist(ctx, req.(*PlaylistReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sportsmatrix_GetPlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsmatrixServer).GetPlaylists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matrix.v1.Sportsmatrix/GetPlaylists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsmatrixServer).GetPlaylists(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sportsmatrix_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsmatrixServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matrix.v1.Sportsmatrix/ReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsmatrixServer).ReloadConfig(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sportsmatrix_ResetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsmatrixServer).ResetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matrix.v1.Sportsmatrix/ResetState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsmatrixServer).ResetState(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sportsmatrix_Record_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsmatrixServer).Record(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matrix.v1.Sportsmatrix/Record",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsmatrixServer).Record(ctx, req.(*RecordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sportsmatrix_StopRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsmatrixServer).StopRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matrix.v1.Sportsmatrix/StopRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsmatrixServer).StopRecording(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sportsmatrix_GetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsmatrixServer).GetHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matrix.v1.Sportsmatrix/GetHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsmatrixServer).GetHealth(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sportsmatrix_SetBrightness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrightnessReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsmatrixServer).SetBrightness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matrix.v1.Sportsmatrix/SetBrightness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsmatrixServer).SetBrightness(ctx, req.(*BrightnessReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sportsmatrix_GetBrightness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SportsmatrixServer).GetBrightness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matrix.v1.Sportsmatrix/GetBrightness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SportsmatrixServer).GetBrightness(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Sportsmatrix_ServiceDesc is the grpc.ServiceDesc for Sportsmatrix service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -424,6 +712,200 @@ var Sportsmatrix_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetLiveOnly",
 			Handler:    _Sportsmatrix_SetLiveOnly_Handler,
 		},
+		{
+			MethodName: "SetPlaylist",
+			Handler:    _Sportsmatrix_SetPlaylist_Handler,
+		},
+		{
+			MethodName: "GetPlaylists",
+			Handler:    _Sportsmatrix_GetPlaylists_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _Sportsmatrix_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "ResetState",
+			Handler:    _Sportsmatrix_ResetState_Handler,
+		},
+		{
+			MethodName: "Record",
+			Handler:    _Sportsmatrix_Record_Handler,
+		},
+		{
+			MethodName: "StopRecording",
+			Handler:    _Sportsmatrix_StopRecording_Handler,
+		},
+		{
+			MethodName: "GetHealth",
+			Handler:    _Sportsmatrix_GetHealth_Handler,
+		},
+		{
+			MethodName: "SetBrightness",
+			Handler:    _Sportsmatrix_SetBrightness_Handler,
+		},
+		{
+			MethodName: "GetBrightness",
+			Handler:    _Sportsmatrix_GetBrightness_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sportsmatrix/sportsmatrix.proto",
+}
+
+// ConfigClient is the client API for Config service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConfigClient interface {
+	GetConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ConfigResp, error)
+	GetConfigSchema(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ConfigSchemaResp, error)
+	UpdateConfig(ctx context.Context, in *UpdateConfigReq, opts ...grpc.CallOption) (*ReloadConfigResp, error)
+}
+
+type configClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConfigClient(cc grpc.ClientConnInterface) ConfigClient {
+	return &configClient{cc}
+}
+
+func (c *configClient) GetConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ConfigResp, error) {
+	out := new(ConfigResp)
+	err := c.cc.Invoke(ctx, "/matrix.v1.Config/GetConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetConfigSchema(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ConfigSchemaResp, error) {
+	out := new(ConfigSchemaResp)
+	err := c.cc.Invoke(ctx, "/matrix.v1.Config/GetConfigSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateConfig(ctx context.Context, in *UpdateConfigReq, opts ...grpc.CallOption) (*ReloadConfigResp, error) {
+	out := new(ReloadConfigResp)
+	err := c.cc.Invoke(ctx, "/matrix.v1.Config/UpdateConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConfigServer is the server API for Config service.
+// All implementations must embed UnimplementedConfigServer
+// for forward compatibility
+type ConfigServer interface {
+	GetConfig(context.Context, *empty.Empty) (*ConfigResp, error)
+	GetConfigSchema(context.Context, *empty.Empty) (*ConfigSchemaResp, error)
+	UpdateConfig(context.Context, *UpdateConfigReq) (*ReloadConfigResp, error)
+	mustEmbedUnimplementedConfigServer()
+}
+
+// UnimplementedConfigServer must be embedded to have forward compatible implementations.
+type UnimplementedConfigServer struct {
+}
+
+func (UnimplementedConfigServer) GetConfig(context.Context, *empty.Empty) (*ConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedConfigServer) GetConfigSchema(context.Context, *empty.Empty) (*ConfigSchemaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigSchema not implemented")
+}
+func (UnimplementedConfigServer) UpdateConfig(context.Context, *UpdateConfigReq) (*ReloadConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfig not implemented")
+}
+func (UnimplementedConfigServer) mustEmbedUnimplementedConfigServer() {}
+
+// UnsafeConfigServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConfigServer will
+// result in compilation errors.
+type UnsafeConfigServer interface {
+	mustEmbedUnimplementedConfigServer()
+}
+
+func RegisterConfigServer(s grpc.ServiceRegistrar, srv ConfigServer) {
+	s.RegisterService(&Config_ServiceDesc, srv)
+}
+
+func _Config_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matrix.v1.Config/GetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetConfig(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_GetConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetConfigSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matrix.v1.Config/GetConfigSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetConfigSchema(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_UpdateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/matrix.v1.Config/UpdateConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateConfig(ctx, req.(*UpdateConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Config_ServiceDesc is the grpc.ServiceDesc for Config service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Config_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "matrix.v1.Config",
+	HandlerType: (*ConfigServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetConfig",
+			Handler:    _Config_GetConfig_Handler,
+		},
+		{
+			MethodName: "GetConfigSchema",
+			Handler:    _Config_GetConfigSchema_Handler,
+		},
+		{
+			MethodName: "UpdateConfig",
+			Handler:    _Config_UpdateConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sportsmatrix/sportsmatrix.proto",
@@ -11,6 +11,10 @@ import (
 type HTTPHandler struct {
 	Handler func(http.ResponseWriter, *http.Request)
 	Path    string
+	// ReadOnly endpoints don't change anything, so read-only API tokens may call them
+	ReadOnly bool
+	// Public endpoints are served without a token, ie. to the web board kiosk, which can't log in
+	Public bool
 }
 
 // Enabler is an interface for basic Enable/Disable functions
@@ -33,6 +37,12 @@ type Board interface {
 	SetStateChangeNotifier(StateChangeNotifier)
 }
 
+// Prioritizer is implemented by boards that can request to preempt the normal
+// board rotation, such as when a favorite team's game goes live
+type Prioritizer interface {
+	HasPriority(ctx context.Context) bool
+}
+
 // Canvas ...
 type Canvas interface {
 	image.Image
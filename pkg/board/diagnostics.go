@@ -0,0 +1,69 @@
+package board
+
+import (
+	"sync"
+	"time"
+)
+
+// Reasons a board was skipped
+const (
+	SkipDisabled   = "disabled"
+	SkipOffHours   = "off hours"
+	SkipNoGames    = "no games"
+	SkipAPIFailure = "API failure"
+)
+
+// Diagnostics describes the state of a board's data
+type Diagnostics struct {
+	// DataUpdated is when the board last loaded its data successfully
+	DataUpdated time.Time
+	// SkipReason is why the board last had nothing to show
+	SkipReason string
+}
+
+// Diagnoser is implemented by boards that can report on the state of their data
+type Diagnoser interface {
+	Diagnostics() *Diagnostics
+}
+
+// DiagnosticsTracker tracks the Diagnostics for a board
+type DiagnosticsTracker struct {
+	diag     Diagnostics
+	offHours bool
+	sync.Mutex
+}
+
+// DataLoaded records that the board's data was loaded successfully
+func (d *DiagnosticsTracker) DataLoaded() {
+	d.Lock()
+	defer d.Unlock()
+	d.diag.DataUpdated = time.Now()
+	d.diag.SkipReason = ""
+}
+
+// Skipped records why the board had nothing to show
+func (d *DiagnosticsTracker) Skipped(reason string) {
+	d.Lock()
+	defer d.Unlock()
+	d.diag.SkipReason = reason
+}
+
+// SetOffHours records whether the board was turned off by its on/off schedule
+func (d *DiagnosticsTracker) SetOffHours(off bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.offHours = off
+}
+
+// Diagnostics returns the current Diagnostics
+func (d *DiagnosticsTracker) Diagnostics() *Diagnostics {
+	d.Lock()
+	defer d.Unlock()
+
+	diag := d.diag
+	if d.offHours {
+		diag.SkipReason = SkipOffHours
+	}
+
+	return &diag
+}
@@ -0,0 +1,38 @@
+package board
+
+import (
+	"context"
+
+	"go.uber.org/atomic"
+)
+
+// StateRecorder persists config toggles that are changed through the API, such as a board
+// being disabled, so that the change survives a restart
+type StateRecorder interface {
+	// RecordToggles persists the current value of each toggle
+	RecordToggles(toggles ...*atomic.Bool)
+}
+
+// Toggler is implemented by boards whose enabled state is a config toggle, so that
+// the matrix can persist enabling or disabling them, ie. when every board is disabled
+type Toggler interface {
+	EnabledToggle() *atomic.Bool
+}
+
+type stateRecorderKey struct{}
+
+// WithStateRecorder returns a context that API handlers use to persist the toggles they change
+func WithStateRecorder(ctx context.Context, r StateRecorder) context.Context {
+	return context.WithValue(ctx, stateRecorderKey{}, r)
+}
+
+// RecordToggles persists toggles that an API request changed. Handlers call this after they
+// change a toggle, as only changes made through the API are persisted. Changes made on a
+// schedule or by a config reload aren't. It does nothing when the context has no StateRecorder
+func RecordToggles(ctx context.Context, toggles ...*atomic.Bool) {
+	r, ok := ctx.Value(stateRecorderKey{}).(StateRecorder)
+	if !ok || r == nil {
+		return
+	}
+	r.RecordToggles(toggles...)
+}
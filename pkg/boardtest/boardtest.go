@@ -0,0 +1,218 @@
+// Package boardtest renders boards into an in-memory matrix, and compares what they draw against
+// golden images checked in to each board's testdata/golden directory.
+//
+// Run a board's tests with -update to regenerate its golden images, ie.
+//
+//	go test ./pkg/sportboard/ -run Golden -update
+package boardtest
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/robbydyer/sports/pkg/board"
+	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
+	"github.com/robbydyer/sports/pkg/rgbrender"
+)
+
+var update = flag.Bool("update", false, "Regenerate the golden images in testdata/golden")
+
+// Geometries are the matrix sizes boards are tested at
+var Geometries = []image.Point{
+	{X: 64, Y: 32},
+	{X: 128, Y: 64},
+	{X: 256, Y: 64},
+}
+
+var (
+	// ChannelTolerance is how much a pixel's color channels can differ from the golden image before
+	// the pixel counts as changed
+	ChannelTolerance = 16
+	// PixelTolerance is the fraction of pixels that can change before an image doesn't match
+	PixelTolerance = 0.005
+	// RenderTimeout is how long a board has to draw its first frame
+	RenderTimeout = 30 * time.Second
+)
+
+// captureMatrix sends each non-blank frame rendered to it
+type captureMatrix struct {
+	*rgb.MemoryMatrix
+	frames chan *image.RGBA
+}
+
+// Render ...
+func (m *captureMatrix) Render() error {
+	w, h := m.Geometry()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	blank := true
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			r, g, b, _ := m.At(x + (y * w)).RGBA()
+			if r|g|b != 0 {
+				blank = false
+			}
+			img.SetRGBA(x, y, color.RGBA{uint8(r >> 8), uint8(g >> 8), uint8(b >> 8), 255})
+		}
+	}
+
+	// Boards clear the matrix between screens
+	if blank {
+		return nil
+	}
+
+	select {
+	case m.frames <- img:
+	default:
+	}
+
+	return nil
+}
+
+// Name returns the name for a golden image of a board at a geometry, ie. nhl-live-64x32
+func Name(name string, size image.Point) string {
+	return fmt.Sprintf("%s-%dx%d", name, size.X, size.Y)
+}
+
+// Render renders the board to an in-memory matrix of the given size, and returns the first frame it
+// draws. Boards with multiple screens, ie. one per game, are canceled after their first
+func Render(t testing.TB, b board.Board, size image.Point) *image.RGBA {
+	t.Helper()
+
+	m := &captureMatrix{
+		MemoryMatrix: rgb.NewMemoryMatrix(size.X, size.Y),
+		frames:       make(chan *image.RGBA, 1),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- b.Render(ctx, rgb.NewCanvas(m))
+	}()
+
+	select {
+	case img := <-m.frames:
+		return img
+	case err := <-errs:
+		// The frame may have been sent just before Render returned
+		select {
+		case img := <-m.frames:
+			return img
+		default:
+		}
+		t.Fatalf("board %s rendered nothing at %dx%d: %v", b.Name(), size.X, size.Y, err)
+	case <-time.After(RenderTimeout):
+		t.Fatalf("board %s didn't render at %dx%d within %s", b.Name(), size.X, size.Y, RenderTimeout)
+	}
+
+	return nil
+}
+
+// Golden compares img against testdata/golden/<name>.png, within the tolerances. When they don't
+// match, the rendered image and a diff of the changed pixels are written to testdata/failed
+func Golden(t testing.TB, name string, img image.Image) {
+	t.Helper()
+
+	goldenFile := filepath.Join("testdata", "golden", name+".png")
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(goldenFile), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := rgbrender.SavePng(img, goldenFile); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	golden, err := readPng(goldenFile)
+	if err != nil {
+		t.Fatalf("failed to read golden image, run with -update to create it: %v", err)
+	}
+
+	diff, changed := compare(golden, img)
+	total := img.Bounds().Dx() * img.Bounds().Dy()
+	if diff != nil && float64(changed) <= float64(total)*PixelTolerance {
+		return
+	}
+
+	failDir := filepath.Join("testdata", "failed")
+	if err := os.MkdirAll(failDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	actualFile := filepath.Join(failDir, name+".png")
+	if err := rgbrender.SavePng(img, actualFile); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff == nil {
+		t.Fatalf("%s is %v, golden image is %v. Rendered image written to %s",
+			name, img.Bounds().Size(), golden.Bounds().Size(), actualFile,
+		)
+	}
+
+	diffFile := filepath.Join(failDir, name+".diff.png")
+	if err := rgbrender.SavePng(diff, diffFile); err != nil {
+		t.Fatal(err)
+	}
+	t.Fatalf("%s has %d of %d pixels different from the golden image. Rendered image written to %s, diff to %s",
+		name, changed, total, actualFile, diffFile,
+	)
+}
+
+// compare returns an image of the golden image dimmed, with the pixels that changed in red, and how many
+// changed. The diff is nil if the images are different sizes
+func compare(golden image.Image, img image.Image) (*image.RGBA, int) {
+	if golden.Bounds().Size() != img.Bounds().Size() {
+		return nil, 0
+	}
+
+	gMin := golden.Bounds().Min
+	iMin := img.Bounds().Min
+	diff := image.NewRGBA(image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy()))
+	draw.Draw(diff, diff.Bounds(), golden, gMin, draw.Src)
+
+	changed := 0
+	for y := 0; y < diff.Bounds().Dy(); y++ {
+		for x := 0; x < diff.Bounds().Dx(); x++ {
+			gr, gg, gb, _ := golden.At(gMin.X+x, gMin.Y+y).RGBA()
+			ir, ig, ib, _ := img.At(iMin.X+x, iMin.Y+y).RGBA()
+			if channelDiff(gr, ir) > ChannelTolerance || channelDiff(gg, ig) > ChannelTolerance || channelDiff(gb, ib) > ChannelTolerance {
+				changed++
+				diff.SetRGBA(x, y, color.RGBA{255, 0, 0, 255})
+				continue
+			}
+			diff.SetRGBA(x, y, color.RGBA{uint8(gr >> 10), uint8(gg >> 10), uint8(gb >> 10), 255})
+		}
+	}
+
+	return diff, changed
+}
+
+func channelDiff(a uint32, b uint32) int {
+	d := int(a>>8) - int(b>>8)
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+func readPng(fileName string) (image.Image, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return png.Decode(f)
+}
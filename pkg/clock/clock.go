@@ -11,7 +11,6 @@ import (
 	"time"
 
 	"github.com/golang/freetype/truetype"
-	"github.com/robfig/cron/v3"
 	"github.com/twitchtv/twirp"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -20,6 +19,8 @@ import (
 	"github.com/robbydyer/sports/pkg/board"
 	"github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
 	"github.com/robbydyer/sports/pkg/rgbrender"
+	"github.com/robbydyer/sports/pkg/simclock"
+	"github.com/robbydyer/sports/pkg/twirphelpers"
 )
 
 // Name is the default board name for this Clock
@@ -101,20 +102,12 @@ func New(config *Config, logger *zap.Logger) (*Clock, error) {
 	c.rpcServer = pb.NewBasicBoardServer(svr,
 		twirp.WithServerPathPrefix("/clock"),
 		twirp.ChainHooks(
-			&twirp.ServerHooks{
-				Error: func(ctx context.Context, err twirp.Error) context.Context {
-					c.log.Error("twirp API error",
-						zap.Error(err),
-						zap.String("board", "clock"),
-					)
-					return ctx
-				},
-			},
+			twirphelpers.GetDefaultHooks(c, c.log),
 		),
 	)
 
 	if len(config.OffTimes) > 0 || len(config.OnTimes) > 0 {
-		cr := cron.New()
+		cr := simclock.NewCron()
 		for _, on := range config.OnTimes {
 			c.log.Info("clock will be schedule to turn on",
 				zap.String("turn on", on),
@@ -224,7 +217,7 @@ func (c *Clock) Render(ctx context.Context, canvas board.Canvas) error {
 
 func currentTimeStr() string {
 	ampm := ""
-	h, m, _ := time.Now().Local().Clock()
+	h, m, _ := simclock.Now().Local().Clock()
 	if h >= 12 {
 		h = h - 12
 		ampm = "PM"
@@ -358,8 +351,13 @@ func (c *Clock) render(ctx context.Context, canvas board.Canvas) (board.Canvas,
 	return nil, nil
 }
 
+// EnabledToggle ...
+func (c *Clock) EnabledToggle() *atomic.Bool {
+	return c.config.Enabled
+}
+
 // HasPriority ...
-func (c *Clock) HasPriority() bool {
+func (c *Clock) HasPriority(ctx context.Context) bool {
 	return false
 }
 
@@ -367,20 +365,23 @@ func (c *Clock) HasPriority() bool {
 func (c *Clock) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 	disable := &board.HTTPHandler{
 		Path: "/clock/disable",
-		Handler: func(http.ResponseWriter, *http.Request) {
+		Handler: func(w http.ResponseWriter, req *http.Request) {
 			c.log.Info("disabling clock board")
 			c.Disable()
+			board.RecordToggles(req.Context(), c.config.Enabled)
 		},
 	}
 	enable := &board.HTTPHandler{
 		Path: "/clock/enable",
-		Handler: func(http.ResponseWriter, *http.Request) {
+		Handler: func(w http.ResponseWriter, req *http.Request) {
 			c.log.Info("enabling clock board")
 			c.Enable()
+			board.RecordToggles(req.Context(), c.config.Enabled)
 		},
 	}
 	status := &board.HTTPHandler{
-		Path: "/clock/status",
+		Path:     "/clock/status",
+		ReadOnly: true,
 		Handler: func(w http.ResponseWriter, req *http.Request) {
 			w.Header().Set("Content-Type", "text/plain")
 			if c.Enabled() {
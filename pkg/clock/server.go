@@ -9,6 +9,7 @@ import (
 	"github.com/twitchtv/twirp"
 
 	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+	"github.com/robbydyer/sports/pkg/board"
 )
 
 // Server ...
@@ -27,12 +28,18 @@ func (s *Server) SetStatus(ctx context.Context, req *pb.SetStatusReq) (*emptypb.
 		return &emptypb.Empty{}, twirp.NewError(twirp.InvalidArgument, "nil status sent")
 	}
 
-	s.board.config.ScrollMode.Store(req.Status.ScrollEnabled)
+	if s.board.config.ScrollMode.CAS(!req.Status.ScrollEnabled, req.Status.ScrollEnabled) {
+		board.RecordToggles(ctx, s.board.config.ScrollMode)
+	}
 
 	if req.Status.Enabled {
-		s.board.Enable()
+		if s.board.Enable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
+		}
 	} else {
-		s.board.Disable()
+		if s.board.Disable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
+		}
 	}
 
 	return &emptypb.Empty{}, nil
@@ -0,0 +1,191 @@
+package databoard
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/robbydyer/sports/pkg/board"
+	"github.com/robbydyer/sports/pkg/httpclient"
+)
+
+var templateFuncs = template.FuncMap{
+	"num": func(v interface{}) (float64, error) {
+		f, ok := toFloat(v)
+		if !ok {
+			return 0, fmt.Errorf("%v is not a number", v)
+		}
+		return f, nil
+	},
+}
+
+// getValues returns the board's values, fetching them if they're older than the update interval
+func (d *DataBoard) getValues(ctx context.Context) (map[string]interface{}, error) {
+	d.dataLock.Lock()
+	defer d.dataLock.Unlock()
+
+	if d.values != nil && time.Since(d.lastUpdate) < d.config.updateInterval {
+		return d.values, nil
+	}
+
+	values, err := d.fetch(ctx)
+	if err != nil {
+		d.diagnostics.Skipped(board.SkipAPIFailure)
+		return nil, err
+	}
+
+	d.diagnostics.DataLoaded()
+	d.values = values
+	d.lastUpdate = time.Now()
+
+	return values, nil
+}
+
+// fetch gets the JSON document from the board's URL, and selects its values
+func (d *DataBoard) fetch(ctx context.Context) (map[string]interface{}, error) {
+	req, err := http.NewRequest("GET", d.config.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	for k, v := range d.config.Headers {
+		req.Header.Set(k, v)
+	}
+
+	client := httpclient.Client("data")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("failed to get data from %s: %s", d.config.URL, resp.Status)
+	}
+
+	var doc interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON from %s: %w", d.config.URL, err)
+	}
+
+	values := make(map[string]interface{}, len(d.config.Values))
+	for name, selector := range d.config.Values {
+		v, err := selectPath(doc, selector)
+		if err != nil {
+			return nil, fmt.Errorf("value %s: %w", name, err)
+		}
+		values[name] = v
+	}
+
+	return values, nil
+}
+
+// selectPath returns the value at a JSONPath-style selector in a decoded JSON document, ie.
+// $.data.result[0].value[1]. Negative indexes count from the end of a list, and keys with
+// dots or spaces can be quoted, ie. $["my.key"]
+func selectPath(doc interface{}, selector string) (interface{}, error) {
+	path := strings.TrimPrefix(strings.TrimSpace(selector), "$")
+	current := doc
+
+	for path != "" {
+		var key string
+		var index int
+		isIndex := false
+
+		switch {
+		case strings.HasPrefix(path, `["`) || strings.HasPrefix(path, `['`):
+			quote := path[1:2]
+			end := strings.Index(path[2:], quote+"]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid selector '%s': missing %s]", selector, quote)
+			}
+			key = path[2 : 2+end]
+			path = path[2+end+2:]
+		case strings.HasPrefix(path, "["):
+			end := strings.Index(path, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid selector '%s': missing ]", selector)
+			}
+			i, err := strconv.Atoi(strings.TrimSpace(path[1:end]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid selector '%s': '%s' is not an index", selector, path[1:end])
+			}
+			index = i
+			isIndex = true
+			path = path[end+1:]
+		default:
+			path = strings.TrimPrefix(path, ".")
+			end := strings.IndexAny(path, ".[")
+			if end < 0 {
+				end = len(path)
+			}
+			key = path[:end]
+			path = path[end:]
+			if key == "" {
+				return nil, fmt.Errorf("invalid selector '%s': empty key", selector)
+			}
+		}
+
+		if isIndex {
+			list, ok := current.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("selector '%s': [%d] is not in a list", selector, index)
+			}
+			if index < 0 {
+				index += len(list)
+			}
+			if index < 0 || index >= len(list) {
+				return nil, fmt.Errorf("selector '%s': index %d is out of range", selector, index)
+			}
+			current = list[index]
+			continue
+		}
+
+		obj, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("selector '%s': '%s' is not in an object", selector, key)
+		}
+		current, ok = obj[key]
+		if !ok {
+			return nil, fmt.Errorf("selector '%s': key '%s' not found", selector, key)
+		}
+	}
+
+	return current, nil
+}
+
+// toFloat converts numbers, and strings of numbers as returned by ie. Prometheus, to a float
+func toFloat(v interface{}) (float64, bool) {
+	switch val := v.(type) {
+	case json.Number:
+		f, err := val.Float64()
+		return f, err == nil
+	case float64:
+		return val, true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+		return f, err == nil
+	case bool:
+		if val {
+			return 1, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,362 @@
+package databoard
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+	"github.com/robbydyer/sports/pkg/board"
+	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
+	"github.com/robbydyer/sports/pkg/rgbrender"
+	"github.com/robbydyer/sports/pkg/simclock"
+	"github.com/robbydyer/sports/pkg/twirphelpers"
+)
+
+var validName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// DataBoard implements board.Board. It shows values selected from a JSON API, formatted by a template
+type DataBoard struct {
+	config              *Config
+	log                 *zap.Logger
+	tmpl                *template.Template
+	defaultColor        color.Color
+	textWriters         map[int]*rgbrender.TextWriter
+	rpcServer           pb.TwirpServer
+	stateChangeNotifier board.StateChangeNotifier
+	cancelBoard         chan struct{}
+	diagnostics         board.DiagnosticsTracker
+	values              map[string]interface{}
+	lastUpdate          time.Time
+	dataLock            sync.Mutex
+	sync.Mutex
+}
+
+// Config for a DataBoard
+type Config struct {
+	boardDelay         time.Duration
+	updateInterval     time.Duration
+	scrollDelay        time.Duration
+	Name               string            `json:"name"`
+	URL                string            `json:"url"`
+	Headers            map[string]string `json:"headers"`
+	Values             map[string]string `json:"values"`
+	Template           string            `json:"template"`
+	Color              string            `json:"color"`
+	ColorRules         []*ColorRule      `json:"colorRules"`
+	Enabled            *atomic.Bool      `json:"enabled"`
+	BoardDelay         string            `json:"boardDelay"`
+	UpdateInterval     string            `json:"updateInterval"`
+	ScrollMode         *atomic.Bool      `json:"scrollMode"`
+	TightScrollPadding int               `json:"tightScrollPadding"`
+	ScrollDelay        string            `json:"scrollDelay"`
+	OnTimes            []string          `json:"onTimes"`
+	OffTimes           []string          `json:"offTimes"`
+}
+
+// ColorRule sets the color of lines while a value is above and/or below a threshold
+type ColorRule struct {
+	clr   color.Color
+	Value string   `json:"value"`
+	Above *float64 `json:"above"`
+	Below *float64 `json:"below"`
+	Color string   `json:"color"`
+	// Lines the rule applies to, numbered from 1. Defaults to every line
+	Lines []int `json:"lines"`
+}
+
+// SetDefaults ...
+func (c *Config) SetDefaults() {
+	if c.Enabled == nil {
+		c.Enabled = atomic.NewBool(false)
+	}
+	if c.ScrollMode == nil {
+		c.ScrollMode = atomic.NewBool(false)
+	}
+	if c.BoardDelay != "" {
+		d, err := time.ParseDuration(c.BoardDelay)
+		if err != nil {
+			c.boardDelay = 10 * time.Second
+		} else {
+			c.boardDelay = d
+		}
+	} else {
+		c.boardDelay = 10 * time.Second
+	}
+
+	if c.UpdateInterval != "" {
+		d, err := time.ParseDuration(c.UpdateInterval)
+		if err != nil {
+			c.updateInterval = 1 * time.Minute
+		} else {
+			c.updateInterval = d
+		}
+	} else {
+		c.updateInterval = 1 * time.Minute
+	}
+
+	if c.ScrollDelay != "" {
+		d, err := time.ParseDuration(c.ScrollDelay)
+		if err != nil {
+			c.scrollDelay = rgb.DefaultScrollDelay
+		} else {
+			c.scrollDelay = d
+		}
+	} else {
+		c.scrollDelay = rgb.DefaultScrollDelay
+	}
+}
+
+// New ...
+func New(logger *zap.Logger, config *Config) (*DataBoard, error) {
+	if !validName.MatchString(config.Name) {
+		return nil, fmt.Errorf("invalid data board name '%s', must be letters, numbers, '-' or '_'", config.Name)
+	}
+	if config.URL == "" {
+		return nil, fmt.Errorf("data board %s has no url", config.Name)
+	}
+
+	tmpl, err := template.New(config.Name).Funcs(templateFuncs).Option("missingkey=error").Parse(config.Template)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template for data board %s: %w", config.Name, err)
+	}
+
+	d := &DataBoard{
+		config:       config,
+		log:          logger.With(zap.String("board", config.Name)),
+		tmpl:         tmpl,
+		defaultColor: color.White,
+		textWriters:  make(map[int]*rgbrender.TextWriter),
+		cancelBoard:  make(chan struct{}),
+	}
+
+	if config.Color != "" {
+		d.defaultColor, err = parseColor(config.Color)
+		if err != nil {
+			return nil, fmt.Errorf("data board %s: %w", config.Name, err)
+		}
+	}
+
+	for i, rule := range config.ColorRules {
+		if _, ok := config.Values[rule.Value]; !ok {
+			return nil, fmt.Errorf("data board %s: color rule %d is for unknown value '%s'", config.Name, i, rule.Value)
+		}
+		if rule.Above == nil && rule.Below == nil {
+			return nil, fmt.Errorf("data board %s: color rule %d needs a threshold to be above or below", config.Name, i)
+		}
+		rule.clr, err = parseColor(rule.Color)
+		if err != nil {
+			return nil, fmt.Errorf("data board %s: color rule %d: %w", config.Name, i, err)
+		}
+	}
+
+	svr := &Server{
+		board: d,
+	}
+	d.rpcServer = pb.NewBasicBoardServer(svr,
+		twirp.WithServerPathPrefix(d.pathPrefix()),
+		twirp.ChainHooks(
+			twirphelpers.GetDefaultHooks(d, d.log),
+		),
+	)
+
+	if len(config.OffTimes) > 0 || len(config.OnTimes) > 0 {
+		c := simclock.NewCron()
+		for _, on := range config.OnTimes {
+			d.log.Info("data board will be schedule to turn on",
+				zap.String("turn on", on),
+			)
+			_, err := c.AddFunc(on, func() {
+				d.log.Info("data board turning on")
+				d.Enable()
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to add cron for data board: %w", err)
+			}
+		}
+
+		for _, off := range config.OffTimes {
+			d.log.Info("data board will be schedule to turn off",
+				zap.String("turn off", off),
+			)
+			_, err := c.AddFunc(off, func() {
+				d.log.Info("data board turning off")
+				d.Disable()
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to add cron for data board: %w", err)
+			}
+		}
+
+		c.Start()
+	}
+
+	return d, nil
+}
+
+// pathPrefix is where the board's HTTP and RPC handlers are served, ie. /mydata
+func (d *DataBoard) pathPrefix() string {
+	return "/" + strings.ToLower(d.config.Name)
+}
+
+func (d *DataBoard) enablerCancel(ctx context.Context, cancel context.CancelFunc) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.cancelBoard:
+			cancel()
+			return
+		case <-ticker.C:
+			if !d.config.Enabled.Load() {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// cancel stops the current render, if there is one
+func (d *DataBoard) cancel() {
+	select {
+	case d.cancelBoard <- struct{}{}:
+	default:
+	}
+}
+
+// Diagnostics reports on the state of the board's data
+func (d *DataBoard) Diagnostics() *board.Diagnostics {
+	return d.diagnostics.Diagnostics()
+}
+
+// InBetween ...
+func (d *DataBoard) InBetween() bool {
+	return false
+}
+
+// Name ...
+func (d *DataBoard) Name() string {
+	return d.config.Name
+}
+
+// Enabled ...
+func (d *DataBoard) Enabled() bool {
+	return d.config.Enabled.Load()
+}
+
+// EnabledToggle ...
+func (d *DataBoard) EnabledToggle() *atomic.Bool {
+	return d.config.Enabled
+}
+
+// Enable ...
+func (d *DataBoard) Enable() bool {
+	if d.config.Enabled.CAS(false, true) {
+		if d.stateChangeNotifier != nil {
+			d.stateChangeNotifier()
+		}
+		return true
+	}
+	return false
+}
+
+// Disable ...
+func (d *DataBoard) Disable() bool {
+	if d.config.Enabled.CAS(true, false) {
+		if d.stateChangeNotifier != nil {
+			d.stateChangeNotifier()
+		}
+		return true
+	}
+	return false
+}
+
+// ScrollMode ...
+func (d *DataBoard) ScrollMode() bool {
+	return d.config.ScrollMode.Load()
+}
+
+// SetStateChangeNotifier ...
+func (d *DataBoard) SetStateChangeNotifier(st board.StateChangeNotifier) {
+	d.stateChangeNotifier = st
+}
+
+// GetHTTPHandlers ...
+func (d *DataBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	prefix := d.pathPrefix()
+
+	return []*board.HTTPHandler{
+		{
+			Path: prefix + "/enable",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				d.log.Info("enabling board", zap.String("board", d.Name()))
+				d.Enable()
+				board.RecordToggles(req.Context(), d.config.Enabled)
+			},
+		},
+		{
+			Path: prefix + "/disable",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				d.log.Info("disabling board", zap.String("board", d.Name()))
+				d.Disable()
+				board.RecordToggles(req.Context(), d.config.Enabled)
+			},
+		},
+		{
+			Path:     prefix + "/status",
+			ReadOnly: true,
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				d.log.Debug("get board status", zap.String("board", d.Name()))
+				w.Header().Set("Content-Type", "text/plain")
+				if d.Enabled() {
+					_, _ = w.Write([]byte("true"))
+					return
+				}
+				_, _ = w.Write([]byte("false"))
+			},
+		},
+		{
+			Path: prefix + "/scrollon",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				if d.config.ScrollMode.CAS(false, true) {
+					board.RecordToggles(req.Context(), d.config.ScrollMode)
+					d.cancel()
+				}
+			},
+		},
+		{
+			Path: prefix + "/scrolloff",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				if d.config.ScrollMode.CAS(true, false) {
+					board.RecordToggles(req.Context(), d.config.ScrollMode)
+					d.cancel()
+				}
+			},
+		},
+		{
+			Path:     prefix + "/scrollstatus",
+			ReadOnly: true,
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				d.log.Debug("get board scroll status", zap.String("board", d.Name()))
+				w.Header().Set("Content-Type", "text/plain")
+				if d.config.ScrollMode.Load() {
+					_, _ = w.Write([]byte("true"))
+					return
+				}
+				_, _ = w.Write([]byte("false"))
+			},
+		},
+	}, nil
+}
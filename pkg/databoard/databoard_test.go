@@ -0,0 +1,213 @@
+package databoard
+
+import (
+	"context"
+	"encoding/json"
+	"image"
+	"image/color"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/boardtest"
+	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
+)
+
+var red = color.RGBA{R: 255, A: 255}
+
+const promResponse = `{
+	"status": "success",
+	"data": {
+		"result": [
+			{"metric": {"instance": "pi"}, "value": [1600000000.5, "42.5"]},
+			{"metric": {"instance": "nas"}, "value": [1600000000.5, "7"]}
+		]
+	},
+	"my.key": true
+}`
+
+func testBoard(t *testing.T, config *Config) *DataBoard {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(promResponse))
+	}))
+	t.Cleanup(s.Close)
+
+	config.Name = "test"
+	config.URL = s.URL
+	config.Enabled = atomic.NewBool(true)
+	config.SetDefaults()
+
+	b, err := New(zap.NewNop(), config)
+	require.NoError(t, err)
+
+	return b
+}
+
+func TestSelectPath(t *testing.T) {
+	var doc interface{}
+	dec := json.NewDecoder(strings.NewReader(promResponse))
+	dec.UseNumber()
+	require.NoError(t, dec.Decode(&doc))
+
+	tests := []struct {
+		selector string
+		expected interface{}
+		err      string
+	}{
+		{
+			selector: "$.status",
+			expected: "success",
+		},
+		{
+			selector: "$.data.result[0].value[1]",
+			expected: "42.5",
+		},
+		{
+			selector: "$.data.result[-1].metric.instance",
+			expected: "nas",
+		},
+		{
+			selector: `$["my.key"]`,
+			expected: true,
+		},
+		{
+			selector: "$.data.result[1].value[0]",
+			expected: json.Number("1600000000.5"),
+		},
+		{
+			selector: "$.data.result[2]",
+			err:      "selector '$.data.result[2]': index 2 is out of range",
+		},
+		{
+			selector: "$.data.missing",
+			err:      "selector '$.data.missing': key 'missing' not found",
+		},
+		{
+			selector: "$.status[0]",
+			err:      "selector '$.status[0]': [0] is not in a list",
+		},
+		{
+			selector: "$.data.result[first]",
+			err:      "invalid selector '$.data.result[first]': 'first' is not an index",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.selector, func(t *testing.T) {
+			v, err := selectPath(doc, test.selector)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, v)
+		})
+	}
+}
+
+func TestLines(t *testing.T) {
+	above := 40.0
+	below := 10.0
+	b := testBoard(t, &Config{
+		Values: map[string]string{
+			"load": "$.data.result[0].value[1]",
+			"nas":  "$.data.result[1].value[1]",
+		},
+		Template: "Load {{ printf \"%.0f\" (num .load) }}\n\nNAS {{ .nas }}\n",
+		Color:    "#0f0",
+		ColorRules: []*ColorRule{
+			{
+				Value: "load",
+				Above: &above,
+				Color: "red",
+				Lines: []int{1},
+			},
+			{
+				Value: "nas",
+				Below: &below,
+				Color: "#0000ff",
+			},
+		},
+	})
+
+	values, err := b.getValues(context.Background())
+	require.NoError(t, err)
+
+	lines, err := b.lines(values)
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	require.Equal(t, "Load 42", lines[0].text)
+	require.Equal(t, red, lines[0].clr)
+	require.Equal(t, "NAS 7", lines[1].text)
+	require.Equal(t, color.RGBA{B: 255, A: 255}, lines[1].clr)
+}
+
+func TestRender(t *testing.T) {
+	b := testBoard(t, &Config{
+		Values: map[string]string{
+			"load": "$.data.result[0].value[1]",
+		},
+		Template: "{{ .load }}",
+		Color:    "red",
+	})
+
+	img := boardtest.Render(t, b, image.Point{X: 64, Y: 32})
+
+	found := false
+	bounds := img.Bounds()
+	for x := bounds.Min.X; x < bounds.Max.X && !found; x++ {
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			if img.RGBAAt(x, y).R > 0 {
+				found = true
+				break
+			}
+		}
+	}
+	require.True(t, found)
+	require.False(t, b.Diagnostics().DataUpdated.IsZero())
+}
+
+func TestScrollRender(t *testing.T) {
+	b := testBoard(t, &Config{
+		Values: map[string]string{
+			"load": "$.data.result[0].value[1]",
+			"nas":  "$.data.result[1].value[1]",
+		},
+		Template: "Load {{ .load }}\nNAS {{ .nas }}",
+	})
+
+	canvas, err := rgb.NewScrollCanvas(rgb.NewMemoryMatrix(64, 32), zap.NewNop())
+	require.NoError(t, err)
+
+	c, err := b.ScrollRender(context.Background(), canvas, 0)
+	require.NoError(t, err)
+
+	_, ok := c.(*rgb.ScrollCanvas)
+	require.True(t, ok)
+	require.Equal(t, 64, canvas.GetWidth())
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(zap.NewNop(), &Config{Name: "my data", URL: "http://localhost"})
+	require.EqualError(t, err, "invalid data board name 'my data', must be letters, numbers, '-' or '_'")
+
+	_, err = New(zap.NewNop(), &Config{Name: "data"})
+	require.EqualError(t, err, "data board data has no url")
+
+	_, err = New(zap.NewNop(), &Config{Name: "data", URL: "http://localhost", Color: "reddish"})
+	require.EqualError(t, err, "data board data: invalid color 'reddish', use a name like red or a hex color like #ff0000")
+
+	_, err = New(zap.NewNop(), &Config{
+		Name: "data",
+		URL:  "http://localhost",
+		ColorRules: []*ColorRule{
+			{Value: "load", Color: "red"},
+		},
+	})
+	require.EqualError(t, err, "data board data: color rule 0 is for unknown value 'load'")
+}
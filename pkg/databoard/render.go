@@ -0,0 +1,239 @@
+package databoard
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/image/colornames"
+
+	"github.com/robbydyer/sports/pkg/board"
+	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
+	"github.com/robbydyer/sports/pkg/rgbrender"
+)
+
+// line is a line of text to show, in its color
+type line struct {
+	text string
+	clr  color.Color
+}
+
+// parseColor parses a color name, ie. red, or a hex color, ie. #ff0000 or #f00
+func parseColor(s string) (color.Color, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if clr, ok := colornames.Map[s]; ok {
+		return clr, nil
+	}
+
+	hex := strings.TrimPrefix(s, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) == 6 && strings.HasPrefix(s, "#") {
+		if v, err := strconv.ParseUint(hex, 16, 32); err == nil {
+			return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 255}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid color '%s', use a name like red or a hex color like #ff0000", s)
+}
+
+// lines executes the template with the values, and colors each line by the color rules. Blank lines are dropped
+func (d *DataBoard) lines(values map[string]interface{}) ([]*line, error) {
+	var buf bytes.Buffer
+	if err := d.tmpl.Execute(&buf, values); err != nil {
+		return nil, err
+	}
+
+	var lines []*line
+	for _, text := range strings.Split(buf.String(), "\n") {
+		text = strings.TrimRight(text, " \r\t")
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		lines = append(lines, &line{
+			text: text,
+			clr:  d.lineColor(len(lines)+1, values),
+		})
+	}
+
+	return lines, nil
+}
+
+// lineColor returns the color of the first rule that matches the line, numbered from 1
+func (d *DataBoard) lineColor(num int, values map[string]interface{}) color.Color {
+RULES:
+	for _, rule := range d.config.ColorRules {
+		if len(rule.Lines) > 0 {
+			found := false
+			for _, l := range rule.Lines {
+				if l == num {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue RULES
+			}
+		}
+
+		v, ok := toFloat(values[rule.Value])
+		if !ok {
+			continue
+		}
+		if rule.Above != nil && v <= *rule.Above {
+			continue
+		}
+		if rule.Below != nil && v >= *rule.Below {
+			continue
+		}
+
+		return rule.clr
+	}
+
+	return d.defaultColor
+}
+
+func (d *DataBoard) textWriter(canvasHeight int) (*rgbrender.TextWriter, error) {
+	d.Lock()
+	defer d.Unlock()
+
+	if w, ok := d.textWriters[canvasHeight]; ok {
+		return w, nil
+	}
+
+	writer, err := rgbrender.DefaultTextWriter()
+	if err != nil {
+		return nil, err
+	}
+
+	if canvasHeight <= 256 {
+		writer.FontSize = 8.0
+		writer.YStartCorrection = -2
+	} else {
+		writer.FontSize = 0.25 * float64(canvasHeight)
+		writer.YStartCorrection = -1 * ((canvasHeight / 32) + 1)
+	}
+	d.textWriters[canvasHeight] = writer
+
+	return writer, nil
+}
+
+// Render ...
+func (d *DataBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	c, err := d.render(ctx, canvas, d.config.ScrollMode.Load(), d.config.TightScrollPadding)
+	if err != nil {
+		return err
+	}
+	if c != nil {
+		return c.Render(ctx)
+	}
+
+	return nil
+}
+
+// ScrollRender ...
+func (d *DataBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	return d.render(ctx, canvas, true, padding)
+}
+
+func (d *DataBoard) render(ctx context.Context, canvas board.Canvas, scroll bool, padding int) (board.Canvas, error) {
+	if !d.config.Enabled.Load() {
+		return nil, nil
+	}
+
+	boardCtx, boardCancel := context.WithCancel(ctx)
+	defer boardCancel()
+
+	go d.enablerCancel(boardCtx, boardCancel)
+
+	values, err := d.getValues(boardCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := d.lines(values)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) < 1 {
+		return nil, nil
+	}
+
+	zeroed := rgbrender.ZeroedBounds(canvas.Bounds())
+	writer, err := d.textWriter(zeroed.Dy())
+	if err != nil {
+		return nil, err
+	}
+
+	if !scroll || !canvas.Scrollable() {
+		clrCodes := &rgbrender.ColorChar{}
+		for _, l := range lines {
+			clrCodes.Lines = append(clrCodes.Lines, &rgbrender.ColorCharLine{
+				Chars: []string{l.text},
+				Clrs:  []color.Color{l.clr},
+			})
+		}
+		if err := writer.WriteAlignedColorCodes(rgbrender.CenterCenter, canvas, zeroed, clrCodes); err != nil {
+			return nil, err
+		}
+
+		if err := canvas.Render(boardCtx); err != nil {
+			return nil, err
+		}
+
+		select {
+		case <-boardCtx.Done():
+			return nil, context.Canceled
+		case <-time.After(d.config.boardDelay):
+		}
+
+		return nil, nil
+	}
+
+	base, ok := canvas.(*rgb.ScrollCanvas)
+	if !ok {
+		return nil, fmt.Errorf("unsupported scroll canvas %T", canvas)
+	}
+
+	scrollCanvas, err := rgb.NewScrollCanvas(base.Matrix, d.log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tight scroll canvas: %w", err)
+	}
+	scrollCanvas.SetScrollDirection(rgb.RightToLeft)
+	scrollCanvas.SetScrollSpeed(d.config.scrollDelay)
+
+	origWidth := canvas.GetWidth()
+	defer canvas.SetWidth(origWidth)
+
+	// Each line is scrolled by on its own, like a headline
+	for _, l := range lines {
+		lengths, err := writer.MeasureStrings(canvas, []string{l.text})
+		if err != nil {
+			return nil, err
+		}
+		bounds := image.Rect(zeroed.Min.X, zeroed.Min.Y, zeroed.Min.X+lengths[0], zeroed.Max.Y)
+		canvas.SetWidth(bounds.Dx())
+
+		if err := writer.WriteAligned(rgbrender.CenterCenter, canvas, bounds, []string{l.text}, l.clr); err != nil {
+			d.log.Error("failed to render data board line",
+				zap.String("line", l.text),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		scrollCanvas.AddCanvas(canvas)
+		draw.Draw(canvas, canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Over)
+	}
+
+	scrollCanvas.Merge(padding)
+	return scrollCanvas, nil
+}
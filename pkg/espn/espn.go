@@ -17,6 +17,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/robbydyer/sports/pkg/httpclient"
 	"github.com/robbydyer/sports/pkg/rgbrender"
 	"github.com/robbydyer/sports/pkg/util"
 )
@@ -259,7 +260,7 @@ func pullTeams(ctx context.Context, sport string, league string) ([]byte, error)
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := httpclient.Client("espn")
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -10,11 +10,12 @@ import (
 	"sync"
 	"time"
 
-	"github.com/robfig/cron/v3"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/robbydyer/sports/pkg/logo"
+	"github.com/robbydyer/sports/pkg/metrics"
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/sportboard"
 )
 
@@ -85,7 +86,7 @@ func New(ctx context.Context, leaguer Leaguer, logger *zap.Logger, r rankSetter,
 		offSeason:        make(map[string]bool),
 	}
 
-	c := cron.New()
+	c := simclock.NewCron()
 	if _, err := c.AddFunc("0 5 * * *", func() { e.CacheClear(context.Background()) }); err != nil {
 		return e, fmt.Errorf("failed to set cron job for cacheClear: %w", err)
 	}
@@ -175,12 +176,15 @@ DATES:
 
 		games, ok := e.games[t]
 		if !ok || len(games) == 0 {
+			metrics.CacheMiss("espn")
 			e.log.Info("updating games from API",
 				zap.String("league", e.League()),
 			)
 			if err := e.UpdateGames(ctx, t); err != nil {
 				return nil, err
 			}
+		} else {
+			metrics.CacheHit("espn")
 		}
 
 		games, ok = e.games[t]
@@ -329,6 +333,28 @@ func (e *ESPNBoard) TeamsInConference(conference string) []*Team {
 	return ret
 }
 
+// IsWatchTeam returns whether a configured watch team matches any teams, as GetWatchTeams
+// accepts them: ALL, TOP<N>, a team abbreviation or a conference
+func (e *ESPNBoard) IsWatchTeam(ctx context.Context, team string) (bool, error) {
+	if team == "ALL" {
+		return true, nil
+	}
+	if strings.HasPrefix(team, "TOP") {
+		_, err := strconv.Atoi(strings.TrimPrefix(team, "TOP"))
+		return err == nil, nil
+	}
+	if _, err := e.GetTeams(ctx); err != nil {
+		return false, err
+	}
+	for _, t := range e.teams {
+		if t.GetAbbreviation() == team {
+			return true, nil
+		}
+	}
+
+	return len(e.TeamsInConference(team)) > 0, nil
+}
+
 // teamsInRank grabs all teams within the top X number of rankings
 func (e *ESPNBoard) teamsInRank(top int, season string) []*Team {
 	if len(e.teams) < 1 {
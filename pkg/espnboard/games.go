@@ -14,6 +14,8 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/robbydyer/sports/pkg/httpclient"
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/sportboard"
 )
 
@@ -95,7 +97,7 @@ func (g *Game) IsLive() (bool, error) {
 	if complete {
 		return false, nil
 	}
-	if time.Until(g.GameTime).Minutes() > 0 {
+	if simclock.Until(g.GameTime).Minutes() > 0 {
 		return false, nil
 	}
 	if g.status.Period > 0 {
@@ -181,7 +183,7 @@ func (g *Game) GetUpdate(ctx context.Context) (sportboard.Game, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
-	client := http.DefaultClient
+	client := httpclient.Client("espn")
 
 	req = req.WithContext(ctx)
 
@@ -293,7 +295,7 @@ func (e *ESPNBoard) GetGames(ctx context.Context, dateStr string) ([]*Game, erro
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
-	client := http.DefaultClient
+	client := httpclient.Client("espn")
 
 	req = req.WithContext(ctx)
 
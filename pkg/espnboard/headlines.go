@@ -16,6 +16,8 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/httpclient"
 )
 
 // Headlines ...
@@ -97,7 +99,7 @@ func (h *Headlines) GetText(ctx context.Context) ([]string, error) {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := httpclient.Client("espn")
 
 	h.log.Info("Updating headlines from API",
 		zap.String("url", uri.String()),
@@ -7,9 +7,11 @@ import (
 	"net/http"
 	"net/url"
 	"sync"
-	"time"
 
 	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/httpclient"
+	"github.com/robbydyer/sports/pkg/simclock"
 )
 
 var preferedPolls = []string{"cfp", "ap", "usa"}
@@ -97,7 +99,7 @@ func (n *ncaaf) setRankings(ctx context.Context, e *ESPNBoard, season string, te
 	if err != nil {
 		return err
 	}
-	client := http.DefaultClient
+	client := httpclient.Client("espn")
 
 	req = req.WithContext(ctx)
 
@@ -175,7 +177,7 @@ func (n *ncaaf) latestRankingsYear(data *ncaafRankingsData) int {
 		}
 	}
 	if year == 0 {
-		return time.Now().Year()
+		return simclock.Now().Year()
 	}
 	return year
 }
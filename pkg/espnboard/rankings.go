@@ -11,6 +11,8 @@ import (
 
 	multierror "github.com/hashicorp/go-multierror"
 	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/httpclient"
 )
 
 // defaultRankSetter implements rankSetter
@@ -53,7 +55,7 @@ func (t *Team) setDetails(ctx context.Context, season string, apiPath string, lo
 		return err
 	}
 
-	client := http.DefaultClient
+	client := httpclient.Client("espn")
 
 	req = req.WithContext(ctx)
 
@@ -17,6 +17,8 @@ import (
 
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/httpclient"
 )
 
 //go:embed assets
@@ -254,7 +256,7 @@ func pullTeams(ctx context.Context, endpoint string) ([]byte, error) {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := httpclient.Client("espn")
 
 	resp, err := client.Do(req)
 	if err != nil {
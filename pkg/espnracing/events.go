@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/robbydyer/sports/pkg/httpclient"
 	"github.com/robbydyer/sports/pkg/racingboard"
 )
 
@@ -108,7 +109,7 @@ func (a *API) scheduledEventsFromAPI(ctx context.Context) (*Scoreboard, error) {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := httpclient.Client("espnracing")
 
 	resp, err := client.Do(req)
 	if err != nil {
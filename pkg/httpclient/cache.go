@@ -0,0 +1,76 @@
+package httpclient
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+const (
+	maxCacheEntries = 256
+	maxCacheBody    = 4 << 20
+)
+
+// response is a fully read response, which can be shared by coalesced requests and the cache
+type response struct {
+	status     string
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+// cache holds responses with an ETag or Last-Modified, so they can be revalidated
+type cache struct {
+	entries map[string]*response
+	sync.Mutex
+}
+
+func newCache() *cache {
+	return &cache{
+		entries: make(map[string]*response),
+	}
+}
+
+func (c *cache) get(key string) *response {
+	c.Lock()
+	defer c.Unlock()
+	return c.entries[key]
+}
+
+func (c *cache) set(key string, r *response) {
+	if len(r.body) > maxCacheBody {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= maxCacheEntries {
+		for k := range c.entries {
+			delete(c.entries, k)
+			break
+		}
+	}
+	c.entries[key] = r
+}
+
+// cacheKey identifies identical requests. Credentials are included so responses aren't shared between them
+func cacheKey(req *http.Request) string {
+	return req.Method + " " + req.URL.String() + "\n" + req.Header.Get("Authorization") + "\n" +
+		req.Header.Get("Cookie") + "\n" + req.Header.Get("Accept")
+}
+
+func (r *response) httpResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        r.status,
+		StatusCode:    r.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
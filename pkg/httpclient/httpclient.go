@@ -0,0 +1,327 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/robbydyer/sports/pkg/metrics"
+)
+
+var (
+	defaultRetries         = 3
+	defaultRetryDelay      = 500 * time.Millisecond
+	maxRetryDelay          = 30 * time.Second
+	defaultRateLimit       = 5.0
+	defaultBreakerFailures = 5
+	defaultBreakerCooldown = time.Minute
+)
+
+// ErrCircuitOpen is returned without making a request while a host's circuit breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// Config for the client shared by all data providers
+type Config struct {
+	retryDelay      time.Duration
+	breakerCooldown time.Duration
+	// Retries is how many times failed GETs are retried, with exponential backoff
+	Retries    *int   `json:"retries"`
+	RetryDelay string `json:"retryDelay"`
+	// RateLimit is the requests per second allowed to each host. Defaults to 5, and negative is unlimited
+	RateLimit      float64            `json:"rateLimit"`
+	HostRateLimits map[string]float64 `json:"hostRateLimits"`
+	// BreakerFailures is how many requests in a row must fail before requests to the host are stopped
+	// for the BreakerCooldown
+	BreakerFailures int    `json:"breakerFailures"`
+	BreakerCooldown string `json:"breakerCooldown"`
+}
+
+// shared holds the state shared by every Client, so that rate limits, circuit breakers, the
+// conditional request cache and in-flight requests are per host/URL rather than per provider
+type shared struct {
+	cfg      *Config
+	hosts    map[string]*host
+	cache    *cache
+	inflight map[string]*call
+	sync.Mutex
+}
+
+type host struct {
+	limiter *limiter
+	breaker *breaker
+}
+
+type transport struct {
+	shared *shared
+	next   http.RoundTripper
+}
+
+var (
+	defaultShared = newShared(&Config{})
+	sharedLock    sync.RWMutex
+)
+
+// SetDefaults ...
+func (c *Config) SetDefaults() {
+	if c.Retries == nil {
+		r := defaultRetries
+		c.Retries = &r
+	}
+	if c.RateLimit == 0 {
+		c.RateLimit = defaultRateLimit
+	}
+	if c.BreakerFailures == 0 {
+		c.BreakerFailures = defaultBreakerFailures
+	}
+	c.retryDelay = parseDuration(c.RetryDelay, defaultRetryDelay)
+	c.breakerCooldown = parseDuration(c.BreakerCooldown, defaultBreakerCooldown)
+}
+
+func parseDuration(s string, def time.Duration) time.Duration {
+	if s == "" {
+		return def
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return def
+	}
+	return d
+}
+
+// Configure replaces the config shared by every Client. A nil config uses the defaults
+func Configure(cfg *Config) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+
+	sharedLock.Lock()
+	defer sharedLock.Unlock()
+	defaultShared = newShared(cfg)
+}
+
+func newShared(cfg *Config) *shared {
+	cfg.SetDefaults()
+	return &shared{
+		cfg:      cfg,
+		hosts:    make(map[string]*host),
+		cache:    newCache(),
+		inflight: make(map[string]*call),
+	}
+}
+
+// Client returns an *http.Client for the given provider. GETs are rate limited per host, retried with
+// backoff, stopped by a circuit breaker while the host is down, revalidated with ETag/Last-Modified
+// and coalesced with identical requests already in flight. Metrics are recorded for every attempt
+func Client(provider string) *http.Client {
+	sharedLock.RLock()
+	defer sharedLock.RUnlock()
+
+	return &http.Client{
+		Transport: &transport{
+			shared: defaultShared,
+			next:   metrics.Transport(provider, base),
+		},
+	}
+}
+
+// RoundTrip ...
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return t.do(req)
+	}
+
+	key := cacheKey(req)
+	resp, err := t.shared.coalesce(req.Context(), key, func() (*response, error) {
+		return t.fetch(req, key)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.httpResponse(req), nil
+}
+
+// fetch does a conditional GET if there's a cached response for the request, returning the cached
+// response when the server says it's not modified
+func (t *transport) fetch(req *http.Request, key string) (*response, error) {
+	cached := t.shared.cache.get(key)
+	conditional := req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != ""
+	if cached != nil && !conditional {
+		req = req.Clone(req.Context())
+		if etag := cached.header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if modified := cached.header.Get("Last-Modified"); modified != "" {
+			req.Header.Set("If-Modified-Since", modified)
+		}
+	}
+
+	resp, err := t.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil && !conditional {
+		return cached, nil
+	}
+
+	r := &response{
+		status:     resp.Status,
+		statusCode: resp.StatusCode,
+		header:     resp.Header,
+		body:       body,
+	}
+	if resp.StatusCode == http.StatusOK && (resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != "") {
+		t.shared.cache.set(key, r)
+	}
+
+	return r, nil
+}
+
+// do sends the request, waiting on the host's rate limit and retrying GETs that fail
+func (t *transport) do(req *http.Request) (*http.Response, error) {
+	h := t.shared.host(req.URL.Host)
+	if !h.breaker.allow() {
+		return nil, fmt.Errorf("%w for %s", ErrCircuitOpen, req.URL.Host)
+	}
+
+	idempotent := req.Method == http.MethodGet || req.Method == http.MethodHead
+	delay := t.shared.cfg.retryDelay
+
+	for attempt := 0; ; attempt++ {
+		if err := h.limiter.wait(req.Context()); err != nil {
+			h.breaker.cancel()
+			return nil, err
+		}
+
+		resp, err := t.next.RoundTrip(req)
+		failed := (err != nil && req.Context().Err() == nil) || (err == nil && resp.StatusCode >= 500)
+		retry := failed || (err == nil && resp.StatusCode == http.StatusTooManyRequests)
+
+		if !retry || !idempotent || attempt >= *t.shared.cfg.Retries {
+			if err != nil && req.Context().Err() != nil {
+				h.breaker.cancel()
+			} else {
+				h.breaker.record(failed)
+			}
+			return resp, err
+		}
+
+		wait := jitter(delay)
+		if resp != nil {
+			if after := retryAfter(resp); after > 0 {
+				wait = after
+			}
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			h.breaker.cancel()
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+
+		delay *= 2
+		if delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+	}
+}
+
+// jitter returns a random duration between half and all of d
+func jitter(d time.Duration) time.Duration {
+	half := int64(d / 2)
+	if half <= 0 {
+		return d
+	}
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+func retryAfter(resp *http.Response) time.Duration {
+	secs, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || secs < 0 {
+		return 0
+	}
+	d := time.Duration(secs) * time.Second
+	if d > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return d
+}
+
+func (s *shared) host(name string) *host {
+	s.Lock()
+	defer s.Unlock()
+
+	h, ok := s.hosts[name]
+	if !ok {
+		rate := s.cfg.RateLimit
+		if r, ok := s.cfg.HostRateLimits[name]; ok {
+			rate = r
+		}
+		h = &host{
+			limiter: newLimiter(rate),
+			breaker: &breaker{
+				threshold: s.cfg.BreakerFailures,
+				cooldown:  s.cfg.breakerCooldown,
+			},
+		}
+		s.hosts[name] = h
+	}
+
+	return h
+}
+
+// call is a GET in flight, which identical requests wait on
+type call struct {
+	done chan struct{}
+	resp *response
+	err  error
+}
+
+// coalesce calls fetch, unless an identical request is already in flight, in which case its response
+// is shared
+func (s *shared) coalesce(ctx context.Context, key string, fetch func() (*response, error)) (*response, error) {
+	s.Lock()
+	if c, ok := s.inflight[key]; ok {
+		s.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-c.done:
+		}
+		// The request this was waiting on was canceled by its caller, not us
+		if errors.Is(c.err, context.Canceled) || errors.Is(c.err, context.DeadlineExceeded) {
+			return fetch()
+		}
+		return c.resp, c.err
+	}
+
+	c := &call{done: make(chan struct{})}
+	s.inflight[key] = c
+	s.Unlock()
+
+	c.resp, c.err = fetch()
+
+	s.Lock()
+	delete(s.inflight, key)
+	s.Unlock()
+	close(c.done)
+
+	return c.resp, c.err
+}
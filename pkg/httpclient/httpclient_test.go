@@ -0,0 +1,208 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/robbydyer/sports/pkg/simclock"
+)
+
+func testClient(cfg *Config) *http.Client {
+	return &http.Client{
+		Transport: &transport{
+			shared: newShared(cfg),
+			next:   http.DefaultTransport,
+		},
+	}
+}
+
+func get(t *testing.T, client *http.Client, url string) (int, string, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body), nil
+}
+
+func TestRetryAndBreaker(t *testing.T) {
+	var calls atomic.Int32
+	var down atomic.Bool
+	down.Store(true)
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls.Inc()
+		if down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer svr.Close()
+
+	retries := 2
+	client := testClient(&Config{
+		Retries:         &retries,
+		RetryDelay:      "1ms",
+		RateLimit:       -1,
+		BreakerFailures: 2,
+		BreakerCooldown: "50ms",
+	})
+
+	code, _, err := get(t, client, svr.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, int32(3), calls.Load())
+
+	_, _, err = get(t, client, svr.URL)
+	require.NoError(t, err)
+	require.Equal(t, int32(6), calls.Load())
+
+	// Two failures in a row opens the circuit
+	_, _, err = get(t, client, svr.URL)
+	require.True(t, errors.Is(err, ErrCircuitOpen))
+	require.Equal(t, int32(6), calls.Load())
+
+	down.Store(false)
+	time.Sleep(60 * time.Millisecond)
+
+	code, body, err := get(t, client, svr.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "ok", body)
+}
+
+func TestConditionalAndCoalesce(t *testing.T) {
+	var calls atomic.Int32
+	var notModified atomic.Int32
+	release := make(chan struct{})
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls.Inc()
+		if req.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Inc()
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		<-release
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte("scoreboard"))
+	}))
+	defer svr.Close()
+
+	client := testClient(&Config{RateLimit: -1})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			code, body, err := get(t, client, svr.URL)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, code)
+			require.Equal(t, "scoreboard", body)
+		}()
+	}
+
+	require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+	// Give the rest a chance to queue up behind the first
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	require.Equal(t, int32(1), calls.Load())
+
+	code, body, err := get(t, client, svr.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "scoreboard", body)
+	require.Equal(t, int32(1), notModified.Load())
+}
+
+func TestLimiter(t *testing.T) {
+	l := newLimiter(100)
+	start := time.Now()
+	for i := 0; i < 110; i++ {
+		require.NoError(t, l.wait(context.Background()))
+	}
+	require.GreaterOrEqual(t, int64(time.Since(start)), int64(90*time.Millisecond))
+}
+
+func TestRecordReplay(t *testing.T) {
+	dir := t.TempDir()
+	var score atomic.Int32
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(strconv.Itoa(int(score.Load()))))
+	}))
+	defer svr.Close()
+
+	rec := &recorder{
+		dir:  dir,
+		next: http.DefaultTransport,
+		last: make(map[string][32]byte),
+	}
+	client := &http.Client{Transport: rec}
+	url := svr.URL + "/scoreboard?appid=secret"
+
+	_, body, err := get(t, client, url)
+	require.NoError(t, err)
+	require.Equal(t, "0", body)
+	// Unchanged responses aren't saved again
+	_, _, err = get(t, client, url)
+	require.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+	score.Store(1)
+	_, _, err = get(t, client, url)
+	require.NoError(t, err)
+
+	keys, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	files, err := ioutil.ReadDir(filepath.Join(dir, keys[0].Name()))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	dat, err := ioutil.ReadFile(filepath.Join(dir, keys[0].Name(), files[0].Name()))
+	require.NoError(t, err)
+	require.NotContains(t, string(dat), "secret")
+
+	svr.Close()
+
+	first, err := Replay(dir)
+	require.NoError(t, err)
+	clock, err := simclock.NewSimulated(first, 2)
+	require.NoError(t, err)
+	simclock.Set(clock)
+	defer func() {
+		base = http.DefaultTransport
+		simclock.Set(nil)
+	}()
+	client = &http.Client{Transport: base}
+
+	_, body, err = get(t, client, url)
+	require.NoError(t, err)
+	require.Equal(t, "0", body)
+
+	time.Sleep(100 * time.Millisecond)
+	_, body, err = get(t, client, url)
+	require.NoError(t, err)
+	require.Equal(t, "1", body)
+
+	_, _, err = get(t, client, svr.URL+"/other")
+	require.Error(t, err)
+}
@@ -0,0 +1,231 @@
+package httpclient
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/robbydyer/sports/pkg/simclock"
+)
+
+var base http.RoundTripper = http.DefaultTransport
+
+// redactedParams are query params left out of the URLs saved in recordings
+var redactedParams = []string{"appid", "apikey", "api_key", "key", "token"}
+
+// recording is a response saved by a recorder
+type recording struct {
+	Time       time.Time   `json:"time"`
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+}
+
+// recorder saves every response it gets to a directory
+type recorder struct {
+	dir  string
+	next http.RoundTripper
+	last map[string][32]byte
+	sync.Mutex
+}
+
+// replayer serves responses saved by a recorder, as they were at the time on the clock
+type replayer struct {
+	dir   string
+	first time.Time
+	// recordings are the times of each recording, by key
+	recordings map[string][]int64
+}
+
+// Record saves every response from the upstream APIs to dir, keyed by request
+func Record(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	sharedLock.Lock()
+	defer sharedLock.Unlock()
+	base = &recorder{
+		dir:  dir,
+		next: http.DefaultTransport,
+		last: make(map[string][32]byte),
+	}
+
+	return nil
+}
+
+// Replay serves the responses saved to dir by Record instead of making requests. Each request gets the
+// response that was recorded most recently as of the time on the simclock, so the clock should be set
+// to a time during the recording. The time of the first recorded response is returned
+func Replay(dir string) (time.Time, error) {
+	r := &replayer{
+		dir:        dir,
+		recordings: make(map[string][]int64),
+	}
+
+	keys, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to read replay dir: %w", err)
+	}
+	for _, k := range keys {
+		if !k.IsDir() {
+			continue
+		}
+		files, err := ioutil.ReadDir(filepath.Join(dir, k.Name()))
+		if err != nil {
+			return time.Time{}, err
+		}
+		for _, f := range files {
+			t, err := strconv.ParseInt(strings.TrimSuffix(f.Name(), ".json"), 10, 64)
+			if err != nil {
+				continue
+			}
+			r.recordings[k.Name()] = append(r.recordings[k.Name()], t)
+			if r.first.IsZero() || t < r.first.UnixNano() {
+				r.first = time.Unix(0, t)
+			}
+		}
+		sort.Slice(r.recordings[k.Name()], func(i, j int) bool {
+			return r.recordings[k.Name()][i] < r.recordings[k.Name()][j]
+		})
+	}
+
+	if len(r.recordings) < 1 {
+		return time.Time{}, fmt.Errorf("no recordings found in %s", dir)
+	}
+
+	sharedLock.Lock()
+	defer sharedLock.Unlock()
+	base = r
+
+	return r.first, nil
+}
+
+// recordingKey is the directory name for a request's recordings, ie. site.api.espn.com-3f2a...
+func recordingKey(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Method + " " + req.URL.String()))
+	return req.URL.Host + "-" + hex.EncodeToString(sum[:8])
+}
+
+func redactURL(u *url.URL) string {
+	q := u.Query()
+	for _, p := range redactedParams {
+		for k := range q {
+			if strings.EqualFold(k, p) {
+				q.Set(k, "REDACTED")
+			}
+		}
+	}
+	redacted := *u
+	redacted.RawQuery = q.Encode()
+	return redacted.String()
+}
+
+// RoundTrip ...
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil || resp.StatusCode == http.StatusNotModified {
+		// A 304's body is the same as the last recorded response
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if err := r.save(req, resp, body); err != nil {
+		return nil, fmt.Errorf("failed to record response: %w", err)
+	}
+
+	return resp, nil
+}
+
+// save writes the response, unless it's the same as the last one for the request
+func (r *recorder) save(req *http.Request, resp *http.Response, body []byte) error {
+	key := recordingKey(req)
+	sum := sha256.Sum256(append([]byte(strconv.Itoa(resp.StatusCode)), body...))
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.last[key] == sum {
+		return nil
+	}
+
+	rec := &recording{
+		Time:       simclock.Now(),
+		Method:     req.Method,
+		URL:        redactURL(req.URL),
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+	}
+	dat, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Join(r.dir, key), 0o755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(r.dir, key, fmt.Sprintf("%d.json", rec.Time.UnixNano())), dat, 0o644); err != nil {
+		return err
+	}
+	r.last[key] = sum
+
+	return nil
+}
+
+// RoundTrip ...
+func (r *replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := recordingKey(req)
+	times := r.recordings[key]
+	if len(times) < 1 {
+		return nil, fmt.Errorf("no recorded response for %s %s", req.Method, redactURL(req.URL))
+	}
+
+	// The most recent recording as of now. Requests made before their first recording get the first one
+	now := simclock.Now().UnixNano()
+	i := sort.Search(len(times), func(i int) bool { return times[i] > now }) - 1
+	if i < 0 {
+		i = 0
+	}
+
+	dat, err := ioutil.ReadFile(filepath.Join(r.dir, key, fmt.Sprintf("%d.json", times[i])))
+	if err != nil {
+		return nil, err
+	}
+	var rec *recording
+	if err := json.Unmarshal(dat, &rec); err != nil {
+		return nil, fmt.Errorf("failed to read recorded response: %w", err)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rec.StatusCode, http.StatusText(rec.StatusCode)),
+		StatusCode:    rec.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        rec.Header,
+		Body:          ioutil.NopCloser(bytes.NewReader(rec.Body)),
+		ContentLength: int64(len(rec.Body)),
+		Request:       req,
+	}, nil
+}
@@ -22,16 +22,18 @@ func (i *ImageBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 	return []*board.HTTPHandler{
 		{
 			Path: "/img/disable",
-			Handler: func(http.ResponseWriter, *http.Request) {
+			Handler: func(w http.ResponseWriter, req *http.Request) {
 				i.log.Info("disabling image board")
 				i.Disable()
+				board.RecordToggles(req.Context(), i.config.Enabled)
 			},
 		},
 		{
 			Path: "/img/enable",
-			Handler: func(http.ResponseWriter, *http.Request) {
+			Handler: func(w http.ResponseWriter, req *http.Request) {
 				i.log.Info("enabling image board")
 				i.Enable()
+				board.RecordToggles(req.Context(), i.config.Enabled)
 			},
 		},
 		{
@@ -46,6 +48,7 @@ func (i *ImageBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				i.log.Info("enabled disk cache for image board")
 				i.config.UseDiskCache.Store(true)
+				board.RecordToggles(req.Context(), i.config.UseDiskCache)
 			},
 		},
 		{
@@ -53,11 +56,13 @@ func (i *ImageBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				i.log.Info("disabling disk cache for image board")
 				i.config.UseDiskCache.Store(false)
+				board.RecordToggles(req.Context(), i.config.UseDiskCache)
 				_ = os.RemoveAll(filepath.Join(diskCacheDir))
 			},
 		},
 		{
-			Path: "/img/diskcachestatus",
+			Path:     "/img/diskcachestatus",
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				w.Header().Set("Content-Type", "text/plain")
 				if i.config.UseDiskCache.Load() {
@@ -72,6 +77,7 @@ func (i *ImageBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				i.log.Info("enabled memory cache for image board")
 				i.config.UseMemCache.Store(true)
+				board.RecordToggles(req.Context(), i.config.UseMemCache)
 			},
 		},
 		{
@@ -79,11 +85,13 @@ func (i *ImageBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				i.log.Info("disabling memory cache for image board")
 				i.config.UseMemCache.Store(false)
+				board.RecordToggles(req.Context(), i.config.UseMemCache)
 				i.cacheClear()
 			},
 		},
 		{
-			Path: "/img/memcachestatus",
+			Path:     "/img/memcachestatus",
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				w.Header().Set("Content-Type", "text/plain")
 				if i.config.UseMemCache.Load() {
@@ -94,7 +102,8 @@ func (i *ImageBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			},
 		},
 		{
-			Path: "/img/status",
+			Path:     "/img/status",
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				w.Header().Set("Content-Type", "text/plain")
 				if i.Enabled() {
@@ -13,7 +13,6 @@ import (
 	"time"
 
 	"github.com/disintegration/imaging"
-	"github.com/robfig/cron/v3"
 	"github.com/twitchtv/twirp"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -21,6 +20,7 @@ import (
 	pb "github.com/robbydyer/sports/internal/proto/imageboard"
 	"github.com/robbydyer/sports/pkg/board"
 	"github.com/robbydyer/sports/pkg/rgbrender"
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/twirphelpers"
 	"github.com/robbydyer/sports/pkg/util"
 )
@@ -132,7 +132,7 @@ func New(config *Config, logger *zap.Logger) (*ImageBoard, error) {
 	)
 
 	if len(config.OffTimes) > 0 || len(config.OnTimes) > 0 {
-		c := cron.New()
+		c := simclock.NewCron()
 		for _, on := range config.OnTimes {
 			i.log.Info("imageboard will be schedule to turn on",
 				zap.String("turn on", on),
@@ -184,6 +184,11 @@ func (i *ImageBoard) Enabled() bool {
 	return i.config.Enabled.Load()
 }
 
+// EnabledToggle ...
+func (i *ImageBoard) EnabledToggle() *atomic.Bool {
+	return i.config.Enabled
+}
+
 // InBetween ...
 func (i *ImageBoard) InBetween() bool {
 	return false
@@ -764,7 +769,7 @@ func (i *ImageBoard) getSizedGIFDiskCache(path string) (*gif.GIF, error) {
 }
 
 // HasPriority ...
-func (i *ImageBoard) HasPriority() bool {
+func (i *ImageBoard) HasPriority(ctx context.Context) bool {
 	return false
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/twitchtv/twirp"
 
 	pb "github.com/robbydyer/sports/internal/proto/imageboard"
+	"github.com/robbydyer/sports/pkg/board"
 )
 
 // Server ...
@@ -30,13 +31,21 @@ func (s *Server) SetStatus(ctx context.Context, req *pb.SetStatusReq) (*emptypb.
 	}
 
 	if req.Status.Enabled {
-		s.board.Enable()
+		if s.board.Enable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
+		}
 	} else {
-		s.board.Disable()
+		if s.board.Disable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
+		}
 	}
 
-	s.board.config.UseDiskCache.Store(req.Status.DiskcacheEnabled)
-	s.board.config.UseMemCache.Store(req.Status.MemcacheEnabled)
+	if s.board.config.UseDiskCache.CAS(!req.Status.DiskcacheEnabled, req.Status.DiskcacheEnabled) {
+		board.RecordToggles(ctx, s.board.config.UseDiskCache)
+	}
+	if s.board.config.UseMemCache.CAS(!req.Status.MemcacheEnabled, req.Status.MemcacheEnabled) {
+		board.RecordToggles(ctx, s.board.config.UseMemCache)
+	}
 
 	return &emptypb.Empty{}, nil
 }
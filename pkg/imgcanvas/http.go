@@ -30,13 +30,16 @@ func (i *ImgCanvas) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 		},
 	}
 	render := &board.HTTPHandler{
-		Path: "/api/imgcanvas/board",
+		Path:     "/api/imgcanvas/board",
+		ReadOnly: true,
+		Public:   true,
 		Handler: func(w http.ResponseWriter, req *http.Request) {
 			i.Enable()
 
 			i.log.Debug("getting image for web board")
 
-			if len(i.lastPng) == 0 {
+			f, _ := i.currentFrame()
+			if f == nil {
 				i.log.Warn("web board is not ready yet, loading")
 				w.Header().Set("Content-Type", "image/gif")
 				if _, err := w.Write(loading); err != nil {
@@ -45,11 +48,16 @@ func (i *ImgCanvas) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				return
 			}
 
+			b, err := f.pngBytes()
+			if err != nil {
+				i.log.Error("failed to encode png for /api/imgcanvas/board", zap.Error(err))
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
 			w.Header().Set("Content-Type", "image/png")
 
-			i.Lock()
-			defer i.Unlock()
-			if _, err := w.Write(i.lastPng); err != nil {
+			if _, err := w.Write(b); err != nil {
 				i.log.Error("failed to copy png for /api/imgcanvas/board", zap.Error(err))
 				return
 			}
@@ -57,9 +65,17 @@ func (i *ImgCanvas) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 		},
 	}
 
+	stream := &board.HTTPHandler{
+		Path:     "/api/imgcanvas/stream",
+		ReadOnly: true,
+		Public:   true,
+		Handler:  i.stream,
+	}
+
 	return []*board.HTTPHandler{
 		enable,
 		disable,
 		render,
+		stream,
 	}, nil
 }
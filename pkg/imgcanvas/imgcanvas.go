@@ -1,11 +1,9 @@
 package imgcanvas
 
 import (
-	"bytes"
 	"context"
 	"image"
 	"image/color"
-	"image/png"
 	"sync"
 	"time"
 
@@ -16,25 +14,28 @@ import (
 // ImgCanvas is a board.Canvas type that just stores the state
 // as an image.Image
 type ImgCanvas struct {
-	width   int
-	height  int
-	pixels  []uint32
-	lastPng []byte
-	enabled *atomic.Bool
-	log     *zap.Logger
-	done    chan struct{}
+	width       int
+	height      int
+	pixels      []uint32
+	frame       *frame
+	frameSeq    uint64
+	frameUpdate chan struct{}
+	enabled     *atomic.Bool
+	log         *zap.Logger
+	done        chan struct{}
 	sync.Mutex
 }
 
 // New ...
 func New(width int, height int, logger *zap.Logger) *ImgCanvas {
 	i := &ImgCanvas{
-		width:   width,
-		height:  height,
-		pixels:  make([]uint32, (width * height)),
-		enabled: atomic.NewBool(false),
-		log:     logger,
-		done:    make(chan struct{}),
+		width:       width,
+		height:      height,
+		pixels:      make([]uint32, (width * height)),
+		frameUpdate: make(chan struct{}),
+		enabled:     atomic.NewBool(false),
+		log:         logger,
+		done:        make(chan struct{}),
 	}
 
 	_ = i.Clear()
@@ -77,7 +78,7 @@ func (i *ImgCanvas) GetWidth() int {
 }
 
 // disableWatcher checks if the canvas is disabled for 20 sec consecutively in 500ms increments.
-// If so, it clears the lastPng cache to save memory.
+// If so, it clears the last frame to save memory.
 func (i *ImgCanvas) disableWatcher() {
 	ticker := time.NewTicker(500 * time.Millisecond)
 	ticks := 0
@@ -90,10 +91,12 @@ func (i *ImgCanvas) disableWatcher() {
 			if !i.Enabled() {
 				if ticks >= 20 {
 					ticks = 0
-					if len(i.lastPng) > 0 {
+					i.Lock()
+					if i.frame != nil {
 						i.log.Warn("imgcanvas has been disabled for 20sec, clearing cache")
-						i.lastPng = []byte{}
+						i.frame = nil
 					}
+					i.Unlock()
 				}
 			} else {
 				ticks = 0
@@ -114,7 +117,8 @@ func (i *ImgCanvas) blackOut() {
 	}
 }
 
-// Render stores the state of the image as a PNG
+// Render stores the state of the image as the latest frame, if it has changed. Frames
+// are only encoded when requested
 func (i *ImgCanvas) Render(ctx context.Context) error {
 	defer i.blackOut()
 
@@ -122,15 +126,18 @@ func (i *ImgCanvas) Render(ctx context.Context) error {
 		return nil
 	}
 
-	buf := &bytes.Buffer{}
+	i.Lock()
+	defer i.Unlock()
 
-	if err := png.Encode(buf, i); err != nil {
-		return err
+	if i.frame != nil && i.frame.samePixels(i.pixels) {
+		return nil
 	}
 
-	i.Lock()
-	defer i.Unlock()
-	i.lastPng = buf.Bytes()
+	i.frameSeq++
+	i.frame = newFrame(i.frameSeq, i.width, i.height, i.pixels)
+
+	close(i.frameUpdate)
+	i.frameUpdate = make(chan struct{})
 
 	return nil
 }
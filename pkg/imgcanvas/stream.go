@@ -0,0 +1,276 @@
+package imgcanvas
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/png"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	defaultStreamFPS  = 10
+	maxStreamFPS      = 30
+	maxStreamScale    = 16
+	streamKeepAlive   = 15 * time.Second
+	streamContentType = "text/event-stream"
+)
+
+// frame is a rendered frame of the canvas. Encodings of the frame are cached, so that
+// any number of viewers can be sent the frame without it being encoded again
+type frame struct {
+	seq     uint64
+	width   int
+	height  int
+	pixels  []uint32
+	png     []byte
+	events  map[int][]byte
+	encLock sync.Mutex
+}
+
+type streamConfig struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+	FPS    int `json:"fps"`
+}
+
+type streamFrame struct {
+	Seq    uint64 `json:"seq"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	RGB    string `json:"rgb"`
+}
+
+func newFrame(seq uint64, width int, height int, pixels []uint32) *frame {
+	p := make([]uint32, len(pixels))
+	copy(p, pixels)
+
+	return &frame{
+		seq:    seq,
+		width:  width,
+		height: height,
+		pixels: p,
+		events: make(map[int][]byte),
+	}
+}
+
+func (f *frame) samePixels(pixels []uint32) bool {
+	if len(f.pixels) != len(pixels) {
+		return false
+	}
+	for i, p := range f.pixels {
+		if pixels[i] != p {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *frame) pixel(x, y int) uint32 {
+	return f.pixels[x+(y*f.width)]
+}
+
+// pngBytes returns the frame encoded as a PNG
+func (f *frame) pngBytes() ([]byte, error) {
+	f.encLock.Lock()
+	defer f.encLock.Unlock()
+
+	if f.png != nil {
+		return f.png, nil
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, f.width, f.height))
+	for x := 0; x < f.width; x++ {
+		for y := 0; y < f.height; y++ {
+			img.Set(x, y, uint32ToColor(f.pixel(x, y)))
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, img); err != nil {
+		return nil, err
+	}
+	f.png = buf.Bytes()
+
+	return f.png, nil
+}
+
+// event returns the frame as a server-sent event, downscaled by the given factor. Pixels
+// are sent as raw RGB, 3 bytes per pixel, for the client to upscale
+func (f *frame) event(scale int) ([]byte, error) {
+	f.encLock.Lock()
+	defer f.encLock.Unlock()
+
+	if e, ok := f.events[scale]; ok {
+		return e, nil
+	}
+
+	w, h := scaledSize(f.width, f.height, scale)
+	rgb := make([]byte, 0, w*h*3)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var r, g, b, n uint32
+			for sx := x * scale; sx < (x+1)*scale; sx++ {
+				for sy := y * scale; sy < (y+1)*scale; sy++ {
+					p := f.pixel(sx, sy)
+					r += (p >> 16) & 255
+					g += (p >> 8) & 255
+					b += p & 255
+					n++
+				}
+			}
+			rgb = append(rgb, byte(r/n), byte(g/n), byte(b/n))
+		}
+	}
+
+	data, err := json.Marshal(&streamFrame{
+		Seq:    f.seq,
+		Width:  w,
+		Height: h,
+		RGB:    base64.StdEncoding.EncodeToString(rgb),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	e := []byte(fmt.Sprintf("id: %d\nevent: frame\ndata: %s\n\n", f.seq, data))
+	f.events[scale] = e
+
+	return e, nil
+}
+
+func scaledSize(width int, height int, scale int) (int, int) {
+	return width / scale, height / scale
+}
+
+// currentFrame returns the latest frame and a channel that is closed when a new frame is rendered
+func (i *ImgCanvas) currentFrame() (*frame, chan struct{}) {
+	i.Lock()
+	defer i.Unlock()
+	return i.frame, i.frameUpdate
+}
+
+// stream sends frames to the client as server-sent events as they are rendered. The "scale"
+// query param downscales frames by the given factor, and "fps" limits how often frames are sent
+func (i *ImgCanvas) stream(w http.ResponseWriter, req *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	scale, err := queryInt(req, "scale", 1, 1, maxStreamScale)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	fps, err := queryInt(req, "fps", defaultStreamFPS, 1, maxStreamFPS)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	width, height := scaledSize(i.width, i.height, scale)
+	if width < 1 || height < 1 {
+		http.Error(w, fmt.Sprintf("scale %d is too large", scale), http.StatusBadRequest)
+		return
+	}
+
+	i.Enable()
+
+	w.Header().Set("Content-Type", streamContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	cfg, err := json.Marshal(&streamConfig{
+		Width:  width,
+		Height: height,
+		FPS:    fps,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if _, err := fmt.Fprintf(w, "event: config\ndata: %s\n\n", cfg); err != nil {
+		return
+	}
+	flusher.Flush()
+
+	i.log.Info("web board stream started",
+		zap.String("client", req.RemoteAddr),
+		zap.Int("scale", scale),
+		zap.Int("fps", fps),
+	)
+	defer i.log.Info("web board stream ended",
+		zap.String("client", req.RemoteAddr),
+	)
+
+	interval := time.Second / time.Duration(fps)
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+
+	var lastSeq uint64
+
+	for {
+		f, update := i.currentFrame()
+		if f != nil && f.seq != lastSeq {
+			e, err := f.event(scale)
+			if err != nil {
+				i.log.Error("failed to encode web board frame", zap.Error(err))
+				return
+			}
+			if _, err := w.Write(e); err != nil {
+				return
+			}
+			flusher.Flush()
+			lastSeq = f.seq
+
+			// Frames rendered while waiting are skipped, only the latest is sent
+			select {
+			case <-req.Context().Done():
+				return
+			case <-time.After(interval):
+			}
+			continue
+		}
+
+		select {
+		case <-req.Context().Done():
+			return
+		case <-update:
+		case <-keepAlive.C:
+			if _, err := w.Write([]byte(": keepalive\n\n")); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func queryInt(req *http.Request, param string, def int, min int, max int) (int, error) {
+	val := req.URL.Query().Get(param)
+	if val == "" {
+		return def, nil
+	}
+
+	i, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s '%s': %w", param, val, err)
+	}
+	if i < min {
+		return min, nil
+	}
+	if i > max {
+		return max, nil
+	}
+
+	return i, nil
+}
@@ -0,0 +1,239 @@
+package imgcanvas
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"image/color"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testEvent struct {
+	name string
+	data string
+}
+
+// streamClient opens the stream at the given query and returns a reader of its events
+func streamClient(t *testing.T, server *httptest.Server, query string) (*http.Response, func() testEvent) {
+	resp, err := http.Get(server.URL + "?" + query)
+	require.NoError(t, err)
+
+	r := bufio.NewReader(resp.Body)
+	next := func() testEvent {
+		var e testEvent
+		for {
+			line, err := r.ReadString('\n')
+			require.NoError(t, err)
+			line = strings.TrimSuffix(line, "\n")
+			switch {
+			case line == "":
+				return e
+			case strings.HasPrefix(line, "event: "):
+				e.name = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				e.data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}
+
+	return resp, next
+}
+
+func readConfig(t *testing.T, e testEvent) streamConfig {
+	require.Equal(t, "config", e.name)
+	var cfg streamConfig
+	require.NoError(t, json.Unmarshal([]byte(e.data), &cfg))
+	return cfg
+}
+
+func readFrame(t *testing.T, e testEvent) streamFrame {
+	require.Equal(t, "frame", e.name)
+	var f streamFrame
+	require.NoError(t, json.Unmarshal([]byte(e.data), &f))
+	return f
+}
+
+func TestQueryInt(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected int
+		err      bool
+	}{
+		{query: "", expected: 10},
+		{query: "fps=5", expected: 5},
+		{query: "fps=0", expected: 1},
+		{query: "fps=-3", expected: 1},
+		{query: "fps=100", expected: 30},
+		{query: "fps=fast", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/?"+test.query, nil)
+			i, err := queryInt(req, "fps", 10, 1, 30)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, i)
+		})
+	}
+}
+
+func TestStreamConfig(t *testing.T) {
+	i := New(64, 32, zap.NewNop())
+	defer i.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(i.stream))
+	defer server.Close()
+
+	tests := []struct {
+		query  string
+		width  int
+		height int
+		fps    int
+	}{
+		{query: "", width: 64, height: 32, fps: defaultStreamFPS},
+		{query: "scale=2&fps=5", width: 32, height: 16, fps: 5},
+		{query: "scale=0&fps=0", width: 64, height: 32, fps: 1},
+		{query: "scale=100&fps=100", width: 4, height: 2, fps: maxStreamFPS},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			resp, next := streamClient(t, server, test.query)
+			defer resp.Body.Close()
+
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, streamContentType, resp.Header.Get("Content-Type"))
+			require.Equal(t, streamConfig{
+				Width:  test.width,
+				Height: test.height,
+				FPS:    test.fps,
+			}, readConfig(t, next()))
+		})
+	}
+
+	for _, query := range []string{"scale=big", "fps=fast"} {
+		resp, err := http.Get(server.URL + "?" + query)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode, query)
+	}
+
+	small := New(8, 4, zap.NewNop())
+	defer small.Close()
+	smallServer := httptest.NewServer(http.HandlerFunc(small.stream))
+	defer smallServer.Close()
+
+	resp, err := http.Get(smallServer.URL + "?scale=16")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestStreamChangedFrames(t *testing.T) {
+	i := New(4, 2, zap.NewNop())
+	defer i.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(i.stream))
+	defer server.Close()
+
+	resp, next := streamClient(t, server, "fps=30")
+	defer resp.Body.Close()
+	readConfig(t, next())
+
+	i.Set(0, 0, color.White)
+	require.NoError(t, i.Render(context.Background()))
+
+	f := readFrame(t, next())
+	require.Equal(t, uint64(1), f.Seq)
+	rgb, err := base64.StdEncoding.DecodeString(f.RGB)
+	require.NoError(t, err)
+	require.Len(t, rgb, 4*2*3)
+	require.Equal(t, []byte{255, 255, 255, 0, 0, 0}, rgb[:6])
+
+	// An unchanged frame isn't sent, the next event is the changed frame
+	i.Set(0, 0, color.White)
+	require.NoError(t, i.Render(context.Background()))
+	i.Set(1, 0, color.White)
+	require.NoError(t, i.Render(context.Background()))
+
+	f = readFrame(t, next())
+	require.Equal(t, uint64(2), f.Seq)
+	rgb, err = base64.StdEncoding.DecodeString(f.RGB)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 0, 0, 255, 255, 255}, rgb[:6])
+}
+
+func TestStreamSharedEncoding(t *testing.T) {
+	i := New(4, 2, zap.NewNop())
+	defer i.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(i.stream))
+	defer server.Close()
+
+	var viewers []func() testEvent
+	for _, query := range []string{"scale=2", "scale=2", "scale=1"} {
+		resp, next := streamClient(t, server, query)
+		defer resp.Body.Close()
+		readConfig(t, next())
+		viewers = append(viewers, next)
+	}
+
+	i.Set(0, 0, color.White)
+	require.NoError(t, i.Render(context.Background()))
+
+	var events []testEvent
+	for _, next := range viewers {
+		events = append(events, next())
+	}
+
+	// Viewers at the same scale are sent the same encoding of the frame
+	require.Equal(t, events[0], events[1])
+	require.NotEqual(t, events[0], events[2])
+
+	f, _ := i.currentFrame()
+	f.encLock.Lock()
+	defer f.encLock.Unlock()
+	require.Len(t, f.events, 2)
+	require.Equal(t, "data: "+events[0].data, strings.Split(string(f.events[2]), "\n")[2])
+	require.Equal(t, "data: "+events[2].data, strings.Split(string(f.events[1]), "\n")[2])
+}
+
+func TestStreamClientDisconnect(t *testing.T) {
+	i := New(4, 2, zap.NewNop())
+	defer i.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		i.stream(rec, req)
+		close(done)
+	}()
+
+	// Wait for the stream to start, then disconnect
+	for !i.Enabled() {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("stream didn't end when the client disconnected")
+	}
+	require.Contains(t, rec.Body.String(), "event: config")
+}
@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var (
+	httpRequestDuration = NewHistogram("sportsmatrix_http_request_duration_seconds",
+		"Latency of requests to data providers",
+		DefBuckets,
+		"provider",
+	)
+	httpRequests = NewCounter("sportsmatrix_http_requests_total",
+		"Requests to data providers by status code. The code is 'error' when no response was received",
+		"provider", "code",
+	)
+	cacheRequests = NewCounter("sportsmatrix_cache_requests_total",
+		"Lookups of cached provider data",
+		"provider", "result",
+	)
+)
+
+type transport struct {
+	provider string
+	next     http.RoundTripper
+}
+
+// Client returns an *http.Client that records metrics for requests to the given provider
+func Client(provider string) *http.Client {
+	return &http.Client{
+		Transport: Transport(provider, http.DefaultTransport),
+	}
+}
+
+// Transport wraps an http.RoundTripper to record metrics for requests to the given provider
+func Transport(provider string, next http.RoundTripper) http.RoundTripper {
+	return &transport{
+		provider: provider,
+		next:     next,
+	}
+}
+
+// RoundTrip ...
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	httpRequestDuration.Observe(time.Since(start).Seconds(), t.provider)
+
+	if err != nil {
+		httpRequests.Inc(t.provider, "error")
+		return resp, err
+	}
+	httpRequests.Inc(t.provider, strconv.Itoa(resp.StatusCode))
+
+	return resp, nil
+}
+
+// CacheHit records that a provider's data was found in its cache
+func CacheHit(provider string) {
+	cacheRequests.Inc(provider, "hit")
+}
+
+// CacheMiss records that a provider's data had to be fetched
+func CacheMiss(provider string) {
+	cacheRequests.Inc(provider, "miss")
+}
@@ -0,0 +1,305 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefBuckets are the default histogram buckets, in seconds
+var DefBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+var defaultRegistry = &registry{}
+
+type registry struct {
+	metrics []metric
+	sync.Mutex
+}
+
+type metric interface {
+	desc() *desc
+	write(w io.Writer)
+}
+
+type desc struct {
+	name   string
+	help   string
+	typ    string
+	labels []string
+}
+
+// Counter is a cumulative metric that only increases
+type Counter struct {
+	d      *desc
+	values map[string]float64
+	sync.Mutex
+}
+
+// Gauge is a metric that can arbitrarily go up and down
+type Gauge struct {
+	d      *desc
+	values map[string]float64
+	sync.Mutex
+}
+
+// Histogram counts observations in configurable buckets
+type Histogram struct {
+	d       *desc
+	buckets []float64
+	values  map[string]*histogramValue
+	sync.Mutex
+}
+
+type histogramValue struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewCounter registers a new Counter with the given label names
+func NewCounter(name string, help string, labels ...string) *Counter {
+	c := &Counter{
+		d: &desc{
+			name:   name,
+			help:   help,
+			typ:    "counter",
+			labels: labels,
+		},
+		values: make(map[string]float64),
+	}
+	defaultRegistry.register(c)
+
+	return c
+}
+
+// NewGauge registers a new Gauge with the given label names
+func NewGauge(name string, help string, labels ...string) *Gauge {
+	g := &Gauge{
+		d: &desc{
+			name:   name,
+			help:   help,
+			typ:    "gauge",
+			labels: labels,
+		},
+		values: make(map[string]float64),
+	}
+	defaultRegistry.register(g)
+
+	return g
+}
+
+// NewHistogram registers a new Histogram with the given buckets and label names
+func NewHistogram(name string, help string, buckets []float64, labels ...string) *Histogram {
+	b := make([]float64, len(buckets))
+	copy(b, buckets)
+	sort.Float64s(b)
+
+	h := &Histogram{
+		d: &desc{
+			name:   name,
+			help:   help,
+			typ:    "histogram",
+			labels: labels,
+		},
+		buckets: b,
+		values:  make(map[string]*histogramValue),
+	}
+	defaultRegistry.register(h)
+
+	return h
+}
+
+// Handler serves all registered metrics in the Prometheus text format
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		defaultRegistry.write(w)
+	})
+}
+
+// Inc increments the counter for the given label values by 1
+func (c *Counter) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add adds the given value to the counter for the given label values
+func (c *Counter) Add(v float64, labelValues ...string) {
+	key := c.d.key(labelValues)
+	c.Lock()
+	defer c.Unlock()
+	c.values[key] += v
+}
+
+func (c *Counter) desc() *desc {
+	return c.d
+}
+
+func (c *Counter) write(w io.Writer) {
+	c.Lock()
+	defer c.Unlock()
+	writeValues(w, c.d, c.values)
+}
+
+// Set sets the gauge for the given label values
+func (g *Gauge) Set(v float64, labelValues ...string) {
+	key := g.d.key(labelValues)
+	g.Lock()
+	defer g.Unlock()
+	g.values[key] = v
+}
+
+// SetBool sets the gauge to 1 if true, 0 if false
+func (g *Gauge) SetBool(v bool, labelValues ...string) {
+	if v {
+		g.Set(1, labelValues...)
+		return
+	}
+	g.Set(0, labelValues...)
+}
+
+func (g *Gauge) desc() *desc {
+	return g.d
+}
+
+func (g *Gauge) write(w io.Writer) {
+	g.Lock()
+	defer g.Unlock()
+	writeValues(w, g.d, g.values)
+}
+
+// Observe adds an observation to the histogram for the given label values
+func (h *Histogram) Observe(v float64, labelValues ...string) {
+	key := h.d.key(labelValues)
+	h.Lock()
+	defer h.Unlock()
+
+	hv, ok := h.values[key]
+	if !ok {
+		hv = &histogramValue{
+			counts: make([]uint64, len(h.buckets)),
+		}
+		h.values[key] = hv
+	}
+
+	for i, b := range h.buckets {
+		if v <= b {
+			hv.counts[i]++
+		}
+	}
+	hv.count++
+	hv.sum += v
+}
+
+func (h *Histogram) desc() *desc {
+	return h.d
+}
+
+func (h *Histogram) write(w io.Writer) {
+	h.Lock()
+	defer h.Unlock()
+
+	for _, key := range sortedKeys(h.values) {
+		hv := h.values[key]
+		for i, b := range h.buckets {
+			fmt.Fprintf(w, "%s_bucket{%s} %d\n", h.d.name, joinLabels(key, "le", formatFloat(b)), hv.counts[i])
+		}
+		fmt.Fprintf(w, "%s_bucket{%s} %d\n", h.d.name, joinLabels(key, "le", "+Inf"), hv.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", h.d.name, braces(key), formatFloat(hv.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", h.d.name, braces(key), hv.count)
+	}
+}
+
+func (r *registry) register(m metric) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, existing := range r.metrics {
+		if existing.desc().name == m.desc().name {
+			panic(fmt.Sprintf("metric %s is already registered", m.desc().name))
+		}
+	}
+	r.metrics = append(r.metrics, m)
+	sort.Slice(r.metrics, func(i, j int) bool {
+		return r.metrics[i].desc().name < r.metrics[j].desc().name
+	})
+}
+
+func (r *registry) write(out io.Writer) {
+	r.Lock()
+	defer r.Unlock()
+
+	w := bufio.NewWriter(out)
+	defer w.Flush()
+
+	for _, m := range r.metrics {
+		d := m.desc()
+		fmt.Fprintf(w, "# HELP %s %s\n", d.name, d.help)
+		fmt.Fprintf(w, "# TYPE %s %s\n", d.name, d.typ)
+		m.write(w)
+	}
+}
+
+// key formats label values as the label pairs of a series
+func (d *desc) key(labelValues []string) string {
+	pairs := make([]string, 0, len(d.labels))
+	for i, l := range d.labels {
+		v := ""
+		if i < len(labelValues) {
+			v = labelValues[i]
+		}
+		pairs = append(pairs, fmt.Sprintf("%s=%s", l, strconv.Quote(v)))
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+func writeValues(w io.Writer, d *desc, values map[string]float64) {
+	for _, key := range sortedKeys(values) {
+		fmt.Fprintf(w, "%s%s %s\n", d.name, braces(key), formatFloat(values[key]))
+	}
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch v := m.(type) {
+	case map[string]float64:
+		for k := range v {
+			keys = append(keys, k)
+		}
+	case map[string]*histogramValue:
+		for k := range v {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func braces(key string) string {
+	if key == "" {
+		return ""
+	}
+	return fmt.Sprintf("{%s}", key)
+}
+
+func joinLabels(key string, label string, value string) string {
+	pair := fmt.Sprintf("%s=%s", label, strconv.Quote(value))
+	if key == "" {
+		return pair
+	}
+	return fmt.Sprintf("%s,%s", key, pair)
+}
+
+func formatFloat(f float64) string {
+	if math.IsInf(f, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
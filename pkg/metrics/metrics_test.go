@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	counter := NewCounter("test_requests_total", "Test counter", "code")
+	gauge := NewGauge("test_on", "Test gauge")
+	hist := NewHistogram("test_duration_seconds", "Test histogram", []float64{1, 0.5}, "board")
+
+	counter.Inc("200")
+	counter.Add(2, "200")
+	counter.Inc("500")
+	gauge.SetBool(true)
+	hist.Observe(0.25, "nhl")
+	hist.Observe(0.75, "nhl")
+
+	server := httptest.NewServer(Handler())
+	defer server.Close()
+
+	// Scraping through an instrumented client records the request too
+	resp, err := Client("test").Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	CacheHit("test")
+
+	resp, err = http.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	out := string(body)
+
+	for _, line := range []string{
+		"# TYPE test_requests_total counter",
+		`test_requests_total{code="200"} 3`,
+		`test_requests_total{code="500"} 1`,
+		"test_on 1",
+		"# TYPE test_duration_seconds histogram",
+		`test_duration_seconds_bucket{board="nhl",le="0.5"} 1`,
+		`test_duration_seconds_bucket{board="nhl",le="1"} 2`,
+		`test_duration_seconds_bucket{board="nhl",le="+Inf"} 2`,
+		`test_duration_seconds_sum{board="nhl"} 1`,
+		`test_duration_seconds_count{board="nhl"} 2`,
+		`sportsmatrix_http_requests_total{provider="test",code="200"} 1`,
+		`sportsmatrix_http_request_duration_seconds_count{provider="test"} 1`,
+		`sportsmatrix_cache_requests_total{provider="test",result="hit"} 1`,
+	} {
+		require.Contains(t, out, line+"\n")
+	}
+
+	require.Panics(t, func() {
+		NewGauge("test_on", "Duplicate")
+	})
+}
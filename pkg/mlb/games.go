@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/robbydyer/sports/pkg/httpclient"
 	"github.com/robbydyer/sports/pkg/sportboard"
 )
 
@@ -221,7 +222,7 @@ func GetLiveGame(ctx context.Context, link string) (sportboard.Game, error) {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := httpclient.Client("mlb")
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -265,7 +266,7 @@ func getGames(ctx context.Context, dateStr string) ([]*Game, error) {
 	if err != nil {
 		return nil, err
 	}
-	client := http.DefaultClient
+	client := httpclient.Client("mlb")
 
 	req = req.WithContext(ctx)
 
@@ -8,10 +8,10 @@ import (
 
 	"go.uber.org/zap"
 
-	"github.com/robfig/cron/v3"
-
 	"github.com/robbydyer/sports/pkg/espn"
 	"github.com/robbydyer/sports/pkg/logo"
+	"github.com/robbydyer/sports/pkg/metrics"
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/sportboard"
 )
 
@@ -48,7 +48,7 @@ func New(ctx context.Context, logger *zap.Logger) (*MLB, error) {
 		espnAPI: espn.New(logger),
 	}
 
-	c := cron.New()
+	c := simclock.NewCron()
 	if _, err := c.AddFunc("0 5 * * *", func() { m.CacheClear(context.Background()) }); err != nil {
 		return m, fmt.Errorf("failed to set cron job for cacheClear: %w", err)
 	}
@@ -116,9 +116,12 @@ func (m *MLB) GetScheduledGames(ctx context.Context, dates []time.Time) ([]sport
 		dateStr := m.DateStr(date)
 		games, ok := m.games[dateStr]
 		if !ok || len(games) == 0 {
+			metrics.CacheMiss("mlb")
 			if err := m.UpdateGames(ctx, dateStr); err != nil {
 				return nil, err
 			}
+		} else {
+			metrics.CacheHit("mlb")
 		}
 
 		games, ok = m.games[dateStr]
@@ -13,6 +13,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/robbydyer/sports/pkg/httpclient"
 	"github.com/robbydyer/sports/pkg/statboard"
 )
 
@@ -204,7 +205,7 @@ func (p *Player) setStats(ctx context.Context) error {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := httpclient.Client("mlb")
 
 	resp, err := client.Do(req)
 	if err != nil {
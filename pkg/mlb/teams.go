@@ -10,7 +10,9 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
-	"time"
+
+	"github.com/robbydyer/sports/pkg/httpclient"
+	"github.com/robbydyer/sports/pkg/simclock"
 )
 
 //go:embed assets/divisions.json
@@ -90,7 +92,7 @@ func GetTeams(ctx context.Context) ([]*Team, error) {
 	if err != nil {
 		return nil, err
 	}
-	yr := strconv.Itoa(time.Now().Year())
+	yr := strconv.Itoa(simclock.Now().Year())
 	v := uri.Query()
 	v.Set("season", yr)
 	v.Set("leagueIds", "103,104")
@@ -105,7 +107,7 @@ func GetTeams(ctx context.Context) ([]*Team, error) {
 
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := httpclient.Client("mlb")
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -154,7 +156,7 @@ func (t *Team) setRoster(ctx context.Context) error {
 		return err
 	}
 
-	yr := strconv.Itoa(time.Now().Year())
+	yr := strconv.Itoa(simclock.Now().Year())
 	v := uri.Query()
 	v.Set("season", yr)
 
@@ -167,7 +169,7 @@ func (t *Team) setRoster(ctx context.Context) error {
 
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := httpclient.Client("mlb")
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/robbydyer/sports/pkg/httpclient"
 	"github.com/robbydyer/sports/pkg/sportboard"
 )
 
@@ -214,7 +215,7 @@ func GetLiveGame(ctx context.Context, link string) (sportboard.Game, error) {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := httpclient.Client("nhl")
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -249,7 +250,7 @@ func getGames(ctx context.Context, dateStr string) ([]*Game, error) {
 	if err != nil {
 		return nil, err
 	}
-	client := http.DefaultClient
+	client := httpclient.Client("nhl")
 
 	req = req.WithContext(ctx)
 
@@ -6,11 +6,12 @@ import (
 	"sync"
 	"time"
 
-	"github.com/robfig/cron/v3"
 	"go.uber.org/zap"
 
 	"github.com/robbydyer/sports/pkg/espn"
 	"github.com/robbydyer/sports/pkg/logo"
+	"github.com/robbydyer/sports/pkg/metrics"
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/sportboard"
 )
 
@@ -46,7 +47,7 @@ func New(ctx context.Context, logger *zap.Logger) (*NHL, error) {
 		espnAPI: espn.New(logger),
 	}
 
-	c := cron.New()
+	c := simclock.NewCron()
 	if _, err := c.AddFunc("0 5 * * *", func() { n.CacheClear(context.Background()) }); err != nil {
 		return n, fmt.Errorf("failed to set cron job for cacheClear: %w", err)
 	}
@@ -160,9 +161,12 @@ func (n *NHL) GetScheduledGames(ctx context.Context, dates []time.Time) ([]sport
 		dateStr := n.DateStr(date)
 		games, ok := n.games[dateStr]
 		if !ok || len(games) == 0 {
+			metrics.CacheMiss("nhl")
 			if err := n.UpdateGames(ctx, dateStr); err != nil {
 				return nil, err
 			}
+		} else {
+			metrics.CacheHit("nhl")
 		}
 
 		for _, g := range games {
@@ -13,6 +13,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/robbydyer/sports/pkg/httpclient"
 	"github.com/robbydyer/sports/pkg/statboard"
 	"github.com/robbydyer/sports/pkg/util"
 )
@@ -203,7 +204,7 @@ func (p *Player) setStats(ctx context.Context) error {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := httpclient.Client("nhl")
 
 	resp, err := client.Do(req)
 	if err != nil {
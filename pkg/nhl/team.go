@@ -12,6 +12,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/robbydyer/sports/pkg/httpclient"
 	"github.com/robbydyer/sports/pkg/util"
 )
 
@@ -177,7 +178,7 @@ func (n *NHL) getTeamAPIData(ctx context.Context) ([]byte, error) {
 
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := httpclient.Client("nhl")
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list teams: %w", err)
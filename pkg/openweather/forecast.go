@@ -12,6 +12,8 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/robbydyer/sports/pkg/httpclient"
+	"github.com/robbydyer/sports/pkg/metrics"
 	"github.com/robbydyer/sports/pkg/rgbrender"
 	"github.com/robbydyer/sports/pkg/weatherboard"
 )
@@ -126,12 +128,14 @@ func (a *API) getWeather(ctx context.Context, zipCode string, country string, me
 			)
 			w = nil
 		} else {
+			metrics.CacheHit("openweather")
 			a.log.Info("using weather data from cache",
 				zap.String("key", key),
 			)
 			return w, nil
 		}
 	}
+	metrics.CacheMiss("openweather")
 
 	if a.lastAPICall == nil {
 		t := time.Now().Local()
@@ -182,7 +186,7 @@ func (a *API) getWeather(ctx context.Context, zipCode string, country string, me
 	if err != nil {
 		return nil, err
 	}
-	client := http.DefaultClient
+	client := httpclient.Client("openweather")
 
 	req = req.WithContext(ctx)
 
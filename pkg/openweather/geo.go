@@ -9,6 +9,8 @@ import (
 	"net/url"
 
 	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/httpclient"
 )
 
 type geo struct {
@@ -45,7 +47,7 @@ func (a *API) getLocation(ctx context.Context, zipCode string, country string) (
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := httpclient.Client("openweather")
 
 	a.log.Info("querying geolocation",
 		zap.String("url", uri.String()),
@@ -13,8 +13,8 @@ import (
 
 	"go.uber.org/zap"
 
-	"github.com/robfig/cron/v3"
-
+	"github.com/robbydyer/sports/pkg/httpclient"
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/statboard"
 )
 
@@ -49,7 +49,7 @@ func New(logger *zap.Logger, updateInterval time.Duration) (*PGA, error) {
 		lastUpdate:     time.Now().Add(-1 * updateInterval),
 	}
 
-	c := cron.New()
+	c := simclock.NewCron()
 
 	if _, err := c.AddFunc("0 4 * * *", p.cacheClear); err != nil {
 		return nil, err
@@ -129,7 +129,7 @@ func (p *PGA) updatePlayers(ctx context.Context) ([]*Player, error) {
 	}
 	req = req.WithContext(ctx)
 
-	client := http.DefaultClient
+	client := httpclient.Client("pga")
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/statboard"
 )
 
@@ -81,7 +82,7 @@ func (p *Player) GetStat(stat string) string {
 		if err != nil {
 			return p.Status.TeeTime
 		}
-		if time.Until(t) < 0 {
+		if simclock.Until(t) < 0 {
 			return ""
 		}
 		return t.Local().Format("03:04PM")
@@ -0,0 +1,192 @@
+package pluginboard
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"go.uber.org/zap"
+
+	pluginpb "github.com/robbydyer/sports/internal/proto/plugin"
+)
+
+// call is a request that is waiting on its responses. Responses are queued without limit, so
+// a call that is slow to receive them, ie. rendering frames, doesn't hold up the other calls
+type call struct {
+	id        uint64
+	responses []*pluginpb.Response
+	ready     chan struct{}
+}
+
+// client sends requests to a plugin, and routes its responses back to them. Requests
+// are sent concurrently, ie. a status request while frames are being rendered
+type client struct {
+	log       *zap.Logger
+	w         io.Writer
+	close     func() error
+	writeLock sync.Mutex
+	nextID    uint64
+	calls     map[uint64]*call
+	err       error
+	closed    chan struct{}
+	sync.Mutex
+}
+
+func newClient(logger *zap.Logger, r io.Reader, w io.Writer, closeFunc func() error) *client {
+	c := &client{
+		log:    logger,
+		w:      w,
+		close:  closeFunc,
+		calls:  make(map[uint64]*call),
+		closed: make(chan struct{}),
+	}
+
+	go c.read(bufio.NewReader(r))
+
+	return c
+}
+
+func (c *client) read(r *bufio.Reader) {
+	for {
+		resp := &pluginpb.Response{}
+		if err := readMessage(r, resp); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = fmt.Errorf("plugin closed the connection")
+			}
+			c.fail(err)
+			return
+		}
+
+		c.Lock()
+		cl, ok := c.calls[resp.Id]
+		if ok {
+			cl.responses = append(cl.responses, resp)
+		}
+		c.Unlock()
+		if !ok {
+			// The request was canceled
+			continue
+		}
+
+		select {
+		case cl.ready <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// isClosed returns true once the connection to the plugin has failed
+func (c *client) isClosed() bool {
+	select {
+	case <-c.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// fail closes the connection, which ends all the calls waiting on it
+func (c *client) fail(err error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.err != nil {
+		return
+	}
+	c.err = err
+	close(c.closed)
+
+	if err := c.close(); err != nil {
+		c.log.Debug("failed to close plugin connection",
+			zap.Error(err),
+		)
+	}
+}
+
+// send sends a request. Its responses must be received until the last one, and the call finished
+func (c *client) send(req *pluginpb.Request) (*call, error) {
+	c.Lock()
+	if c.err != nil {
+		c.Unlock()
+		return nil, c.err
+	}
+	c.nextID++
+	req.Id = c.nextID
+	cl := &call{
+		id:    req.Id,
+		ready: make(chan struct{}, 1),
+	}
+	c.calls[cl.id] = cl
+	c.Unlock()
+
+	c.writeLock.Lock()
+	err := writeMessage(c.w, req)
+	c.writeLock.Unlock()
+
+	if err != nil {
+		err = fmt.Errorf("failed to send request to plugin: %w", err)
+		c.fail(err)
+		c.finish(cl)
+		return nil, err
+	}
+
+	return cl, nil
+}
+
+// finish stops routing responses to a call
+func (c *client) finish(cl *call) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.calls, cl.id)
+	cl.responses = nil
+}
+
+// receive returns the next response to a call. A response with an error is returned as an error
+func (c *client) receive(ctx context.Context, cl *call) (*pluginpb.Response, error) {
+	for {
+		c.Lock()
+		if len(cl.responses) > 0 {
+			resp := cl.responses[0]
+			cl.responses = cl.responses[1:]
+			c.Unlock()
+
+			if e, ok := resp.Resp.(*pluginpb.Response_Error); ok {
+				return nil, fmt.Errorf("plugin error: %s", e.Error)
+			}
+			return resp, nil
+		}
+		c.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-c.closed:
+			return nil, c.err
+		case <-cl.ready:
+		}
+	}
+}
+
+// status sends a request that is answered with a Status
+func (c *client) status(ctx context.Context, req *pluginpb.Request) (*pluginpb.Status, error) {
+	cl, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer c.finish(cl)
+
+	resp, err := c.receive(ctx, cl)
+	if err != nil {
+		return nil, err
+	}
+
+	status := resp.GetStatus()
+	if status == nil {
+		return nil, fmt.Errorf("plugin answered with %T instead of a status", resp.Resp)
+	}
+
+	return status, nil
+}
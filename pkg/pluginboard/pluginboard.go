@@ -0,0 +1,665 @@
+package pluginboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+	pluginpb "github.com/robbydyer/sports/internal/proto/plugin"
+	"github.com/robbydyer/sports/pkg/board"
+	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
+	"github.com/robbydyer/sports/pkg/rgbrender"
+	"github.com/robbydyer/sports/pkg/simclock"
+	"github.com/robbydyer/sports/pkg/twirphelpers"
+)
+
+var (
+	validName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	// startTimeout is how long a plugin has to start listening on its socket
+	startTimeout  = 10 * time.Second
+	statusTimeout = 5 * time.Second
+)
+
+// PluginBoard implements board.Board. It shows the frames rendered by an external plugin
+type PluginBoard struct {
+	config              *Config
+	log                 *zap.Logger
+	rpcServer           pb.TwirpServer
+	stateChangeNotifier board.StateChangeNotifier
+	cancelBoard         chan struct{}
+	client              *client
+	status              *pluginpb.Status
+	statusLock          sync.Mutex
+	sync.Mutex
+}
+
+// Config for a PluginBoard. The plugin is launched with Command, and talks over its stdin and
+// stdout, unless a Socket is set. Without a Command, the plugin is expected to already be
+// listening on the Socket
+type Config struct {
+	boardDelay         time.Duration
+	scrollDelay        time.Duration
+	Name               string       `json:"name"`
+	Command            []string     `json:"command"`
+	Socket             string       `json:"socket"`
+	Enabled            *atomic.Bool `json:"enabled"`
+	BoardDelay         string       `json:"boardDelay"`
+	ScrollMode         *atomic.Bool `json:"scrollMode"`
+	TightScrollPadding int          `json:"tightScrollPadding"`
+	ScrollDelay        string       `json:"scrollDelay"`
+	OnTimes            []string     `json:"onTimes"`
+	OffTimes           []string     `json:"offTimes"`
+}
+
+// SetDefaults ...
+func (c *Config) SetDefaults() {
+	if c.Enabled == nil {
+		c.Enabled = atomic.NewBool(false)
+	}
+	if c.ScrollMode == nil {
+		c.ScrollMode = atomic.NewBool(false)
+	}
+	if c.BoardDelay != "" {
+		d, err := time.ParseDuration(c.BoardDelay)
+		if err != nil {
+			c.boardDelay = 10 * time.Second
+		} else {
+			c.boardDelay = d
+		}
+	} else {
+		c.boardDelay = 10 * time.Second
+	}
+
+	if c.ScrollDelay != "" {
+		d, err := time.ParseDuration(c.ScrollDelay)
+		if err != nil {
+			c.scrollDelay = rgb.DefaultScrollDelay
+		} else {
+			c.scrollDelay = d
+		}
+	} else {
+		c.scrollDelay = rgb.DefaultScrollDelay
+	}
+}
+
+// New ...
+func New(logger *zap.Logger, config *Config) (*PluginBoard, error) {
+	if !validName.MatchString(config.Name) {
+		return nil, fmt.Errorf("invalid plugin name '%s', must be letters, numbers, '-' or '_'", config.Name)
+	}
+	if len(config.Command) == 0 && config.Socket == "" {
+		return nil, fmt.Errorf("plugin %s has neither a command nor a socket", config.Name)
+	}
+
+	p := &PluginBoard{
+		config:      config,
+		log:         logger.With(zap.String("plugin", config.Name)),
+		cancelBoard: make(chan struct{}),
+	}
+
+	svr := &Server{
+		board: p,
+	}
+	p.rpcServer = pb.NewBasicBoardServer(svr,
+		twirp.WithServerPathPrefix(p.pathPrefix()),
+		twirp.ChainHooks(
+			twirphelpers.GetDefaultHooks(p, p.log),
+		),
+	)
+
+	if len(config.OffTimes) > 0 || len(config.OnTimes) > 0 {
+		c := simclock.NewCron()
+		for _, on := range config.OnTimes {
+			p.log.Info("plugin board will be schedule to turn on",
+				zap.String("turn on", on),
+			)
+			_, err := c.AddFunc(on, func() {
+				p.log.Info("plugin board turning on")
+				p.Enable()
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to add cron for plugin board: %w", err)
+			}
+		}
+
+		for _, off := range config.OffTimes {
+			p.log.Info("plugin board will be schedule to turn off",
+				zap.String("turn off", off),
+			)
+			_, err := c.AddFunc(off, func() {
+				p.log.Info("plugin board turning off")
+				p.Disable()
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to add cron for plugin board: %w", err)
+			}
+		}
+
+		c.Start()
+	}
+
+	return p, nil
+}
+
+// pathPrefix is where the board's HTTP and RPC handlers are served, ie. /myplugin
+func (p *PluginBoard) pathPrefix() string {
+	return "/" + strings.ToLower(p.config.Name)
+}
+
+// getClient returns the connection to the plugin, starting it if it isn't running
+func (p *PluginBoard) getClient(ctx context.Context) (*client, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.client != nil && !p.client.isClosed() {
+		return p.client, nil
+	}
+
+	c, err := p.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Plugins are told whether they're enabled as soon as they're connected to
+	statusCtx, cancel := context.WithTimeout(ctx, statusTimeout)
+	defer cancel()
+	status, err := c.status(statusCtx, &pluginpb.Request{
+		Req: &pluginpb.Request_SetEnabled{
+			SetEnabled: &pluginpb.SetEnabledReq{
+				Enabled: p.config.Enabled.Load(),
+			},
+		},
+	})
+	if err != nil {
+		c.fail(err)
+		return nil, fmt.Errorf("plugin %s failed to start: %w", p.config.Name, err)
+	}
+	p.setStatus(status)
+	p.client = c
+
+	return c, nil
+}
+
+// connect launches the plugin's command, if it has one, and connects to it
+func (p *PluginBoard) connect(ctx context.Context) (*client, error) {
+	if len(p.config.Command) == 0 {
+		conn, err := dial(ctx, p.config.Socket, false)
+		if err != nil {
+			return nil, err
+		}
+		return newClient(p.log, conn, conn, conn.Close), nil
+	}
+
+	p.log.Info("starting plugin",
+		zap.Strings("command", p.config.Command),
+	)
+
+	cmd := exec.Command(p.config.Command[0], p.config.Command[1:]...)
+	cmd.Stderr = os.Stderr
+
+	// The plugin's stdin stays open for as long as it's running, and it should exit once it's closed
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a socket, the plugin talks over stdout. It's closed with the reason once the plugin exits
+	var stdout *io.PipeReader
+	var stdoutWriter *io.PipeWriter
+	if p.config.Socket == "" {
+		stdout, stdoutWriter = io.Pipe()
+		cmd.Stdout = stdoutWriter
+	} else {
+		cmd.Stdout = os.Stdout
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start plugin %s: %w", p.config.Name, err)
+	}
+
+	go func() {
+		err := p.wait(cmd)
+		if stdoutWriter != nil {
+			_ = stdoutWriter.CloseWithError(err)
+		}
+	}()
+
+	kill := func() error {
+		_ = stdin.Close()
+		if err := cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			return err
+		}
+		return nil
+	}
+
+	if stdout != nil {
+		return newClient(p.log, stdout, stdin, kill), nil
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx, startTimeout)
+	defer cancel()
+	conn, err := dial(dialCtx, p.config.Socket, true)
+	if err != nil {
+		_ = kill()
+		return nil, err
+	}
+
+	return newClient(p.log, conn, conn, func() error {
+		_ = conn.Close()
+		return kill()
+	}), nil
+}
+
+// wait waits for the plugin's process to exit
+func (p *PluginBoard) wait(cmd *exec.Cmd) error {
+	err := cmd.Wait()
+	p.log.Info("plugin exited",
+		zap.Error(err),
+	)
+	if err != nil {
+		return fmt.Errorf("plugin exited: %w", err)
+	}
+
+	return fmt.Errorf("plugin exited")
+}
+
+// dial connects to a plugin's socket. When the plugin was just launched, it's retried until the
+// plugin is listening
+func dial(ctx context.Context, socket string, retry bool) (net.Conn, error) {
+	var d net.Dialer
+	for {
+		conn, err := d.DialContext(ctx, "unix", socket)
+		if err == nil || !retry {
+			return conn, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("plugin isn't listening on %s: %w", socket, err)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func (p *PluginBoard) setStatus(status *pluginpb.Status) {
+	p.statusLock.Lock()
+	defer p.statusLock.Unlock()
+	p.status = status
+}
+
+// updateStatus asks the plugin for its status
+func (p *PluginBoard) updateStatus(c *client) {
+	ctx, cancel := context.WithTimeout(context.Background(), statusTimeout)
+	defer cancel()
+
+	status, err := c.status(ctx, &pluginpb.Request{
+		Req: &pluginpb.Request_Status{
+			Status: &pluginpb.StatusReq{},
+		},
+	})
+	if err != nil {
+		p.log.Error("failed to get plugin status",
+			zap.Error(err),
+		)
+		return
+	}
+
+	p.setStatus(status)
+}
+
+// notifyEnabled tells a running plugin whether the board is enabled. Plugins that aren't
+// running yet are told when they start
+func (p *PluginBoard) notifyEnabled() {
+	p.Lock()
+	c := p.client
+	p.Unlock()
+
+	if c == nil || c.isClosed() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), statusTimeout)
+	defer cancel()
+
+	status, err := c.status(ctx, &pluginpb.Request{
+		Req: &pluginpb.Request_SetEnabled{
+			SetEnabled: &pluginpb.SetEnabledReq{
+				Enabled: p.config.Enabled.Load(),
+			},
+		},
+	})
+	if err != nil {
+		p.log.Error("failed to notify plugin of status change",
+			zap.Error(err),
+		)
+		return
+	}
+
+	p.setStatus(status)
+}
+
+// Diagnostics reports the status of the plugin's data
+func (p *PluginBoard) Diagnostics() *board.Diagnostics {
+	p.statusLock.Lock()
+	defer p.statusLock.Unlock()
+
+	d := &board.Diagnostics{}
+	if p.status == nil {
+		return d
+	}
+	if p.status.DataLoaded > 0 {
+		d.DataUpdated = time.Unix(p.status.DataLoaded, 0)
+	}
+	d.SkipReason = p.status.Skipped
+
+	return d
+}
+
+func (p *PluginBoard) enablerCancel(ctx context.Context, cancel context.CancelFunc) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.cancelBoard:
+			cancel()
+			return
+		case <-ticker.C:
+			if !p.config.Enabled.Load() {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// InBetween ...
+func (p *PluginBoard) InBetween() bool {
+	return false
+}
+
+// Name ...
+func (p *PluginBoard) Name() string {
+	return p.config.Name
+}
+
+// Render ...
+func (p *PluginBoard) Render(ctx context.Context, canvas board.Canvas) error {
+	c, err := p.render(ctx, canvas, p.config.ScrollMode.Load(), p.config.TightScrollPadding)
+	if err != nil {
+		return err
+	}
+	if c != nil {
+		return c.Render(ctx)
+	}
+
+	return nil
+}
+
+// ScrollRender ...
+func (p *PluginBoard) ScrollRender(ctx context.Context, canvas board.Canvas, padding int) (board.Canvas, error) {
+	return p.render(ctx, canvas, true, padding)
+}
+
+func (p *PluginBoard) render(ctx context.Context, canvas board.Canvas, scroll bool, padding int) (board.Canvas, error) {
+	if !p.config.Enabled.Load() {
+		return nil, nil
+	}
+
+	boardCtx, boardCancel := context.WithCancel(ctx)
+	defer boardCancel()
+
+	go p.enablerCancel(boardCtx, boardCancel)
+
+	var scrollCanvas *rgb.ScrollCanvas
+	if scroll && canvas.Scrollable() {
+		base, ok := canvas.(*rgb.ScrollCanvas)
+		if !ok {
+			return nil, fmt.Errorf("unsupported scroll canvas %T", canvas)
+		}
+
+		var err error
+		scrollCanvas, err = rgb.NewScrollCanvas(base.Matrix, p.log)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tight scroll canvas: %w", err)
+		}
+		scrollCanvas.SetScrollDirection(rgb.RightToLeft)
+		scrollCanvas.SetScrollSpeed(p.config.scrollDelay)
+	}
+
+	c, err := p.getClient(boardCtx)
+	if err != nil {
+		return nil, err
+	}
+	// Scroll canvases are padded, plugins are sent the size of the matrix
+	bounds := rgbrender.ZeroedBounds(canvas.Bounds())
+	cl, err := c.send(&pluginpb.Request{
+		Req: &pluginpb.Request_Render{
+			Render: &pluginpb.RenderReq{
+				Width:  int32(bounds.Dx()),
+				Height: int32(bounds.Dy()),
+				Scroll: scrollCanvas != nil,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer c.finish(cl)
+
+	for {
+		resp, err := c.receive(boardCtx, cl)
+		if err != nil {
+			return nil, err
+		}
+
+		frame := resp.GetFrame()
+		if frame == nil {
+			return nil, fmt.Errorf("plugin answered a render request with %T", resp.Resp)
+		}
+
+		img, err := frameImage(frame)
+		if err != nil {
+			return nil, err
+		}
+
+		if img != nil {
+			if scrollCanvas != nil {
+				scrollCanvas.AddCanvas(img)
+			} else if err := p.show(boardCtx, canvas, img, frame.DurationMs); err != nil {
+				return nil, err
+			}
+		}
+
+		if frame.Last {
+			break
+		}
+	}
+
+	go p.updateStatus(c)
+
+	if scrollCanvas == nil || scrollCanvas.Len() == 0 {
+		return nil, nil
+	}
+
+	scrollCanvas.Merge(padding)
+	return scrollCanvas, nil
+}
+
+// show renders a frame, and waits for as long as it should be shown
+func (p *PluginBoard) show(ctx context.Context, canvas board.Canvas, img *image.RGBA, durationMs int32) error {
+	draw.Draw(canvas, canvas.Bounds(), image.Black, image.Point{}, draw.Src)
+	draw.Draw(canvas, canvas.Bounds(), img, image.Point{}, draw.Over)
+
+	if err := canvas.Render(ctx); err != nil {
+		return err
+	}
+
+	delay := p.config.boardDelay
+	if durationMs > 0 {
+		delay = time.Duration(durationMs) * time.Millisecond
+	}
+
+	select {
+	case <-ctx.Done():
+		return context.Canceled
+	case <-time.After(delay):
+	}
+
+	return nil
+}
+
+// frameImage returns a frame's image, or nil if the frame has no pixels
+func frameImage(frame *pluginpb.Frame) (*image.RGBA, error) {
+	if len(frame.Rgba) == 0 {
+		return nil, nil
+	}
+
+	w, h := int(frame.Width), int(frame.Height)
+	if w <= 0 || h <= 0 || len(frame.Rgba) != w*h*4 {
+		return nil, fmt.Errorf("plugin sent a %dx%d frame with %d bytes of pixels", w, h, len(frame.Rgba))
+	}
+
+	return &image.RGBA{
+		Pix:    frame.Rgba,
+		Stride: w * 4,
+		Rect:   image.Rect(0, 0, w, h),
+	}, nil
+}
+
+// Enabled ...
+func (p *PluginBoard) Enabled() bool {
+	return p.config.Enabled.Load()
+}
+
+// EnabledToggle ...
+func (p *PluginBoard) EnabledToggle() *atomic.Bool {
+	return p.config.Enabled
+}
+
+// Enable ...
+func (p *PluginBoard) Enable() bool {
+	if p.config.Enabled.CAS(false, true) {
+		if p.stateChangeNotifier != nil {
+			p.stateChangeNotifier()
+		}
+		go p.notifyEnabled()
+		return true
+	}
+	return false
+}
+
+// Disable ...
+func (p *PluginBoard) Disable() bool {
+	if p.config.Enabled.CAS(true, false) {
+		if p.stateChangeNotifier != nil {
+			p.stateChangeNotifier()
+		}
+		go p.notifyEnabled()
+		return true
+	}
+	return false
+}
+
+// ScrollMode ...
+func (p *PluginBoard) ScrollMode() bool {
+	return p.config.ScrollMode.Load()
+}
+
+// SetStateChangeNotifier ...
+func (p *PluginBoard) SetStateChangeNotifier(st board.StateChangeNotifier) {
+	p.stateChangeNotifier = st
+}
+
+// GetHTTPHandlers ...
+func (p *PluginBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
+	prefix := p.pathPrefix()
+
+	return []*board.HTTPHandler{
+		{
+			Path: prefix + "/enable",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				p.log.Info("enabling board", zap.String("board", p.Name()))
+				p.Enable()
+				board.RecordToggles(req.Context(), p.config.Enabled)
+			},
+		},
+		{
+			Path: prefix + "/disable",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				p.log.Info("disabling board", zap.String("board", p.Name()))
+				p.Disable()
+				board.RecordToggles(req.Context(), p.config.Enabled)
+			},
+		},
+		{
+			Path:     prefix + "/status",
+			ReadOnly: true,
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				p.log.Debug("get board status", zap.String("board", p.Name()))
+				w.Header().Set("Content-Type", "text/plain")
+				if p.Enabled() {
+					_, _ = w.Write([]byte("true"))
+					return
+				}
+				_, _ = w.Write([]byte("false"))
+			},
+		},
+		{
+			Path: prefix + "/scrollon",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				if p.config.ScrollMode.CAS(false, true) {
+					board.RecordToggles(req.Context(), p.config.ScrollMode)
+					p.cancel()
+				}
+			},
+		},
+		{
+			Path: prefix + "/scrolloff",
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				if p.config.ScrollMode.CAS(true, false) {
+					board.RecordToggles(req.Context(), p.config.ScrollMode)
+					p.cancel()
+				}
+			},
+		},
+		{
+			Path:     prefix + "/scrollstatus",
+			ReadOnly: true,
+			Handler: func(w http.ResponseWriter, req *http.Request) {
+				p.log.Debug("get board scroll status", zap.String("board", p.Name()))
+				w.Header().Set("Content-Type", "text/plain")
+				if p.config.ScrollMode.Load() {
+					_, _ = w.Write([]byte("true"))
+					return
+				}
+				_, _ = w.Write([]byte("false"))
+			},
+		},
+	}, nil
+}
+
+// cancel stops the current render, if there is one
+func (p *PluginBoard) cancel() {
+	select {
+	case p.cancelBoard <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,229 @@
+package pluginboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	pluginpb "github.com/robbydyer/sports/internal/proto/plugin"
+	"github.com/robbydyer/sports/pkg/boardtest"
+	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
+)
+
+var red = color.RGBA{R: 255, A: 255}
+
+type fakePlugin struct {
+	enabled *atomic.Bool
+	err     error
+	frames  int
+}
+
+func (f *fakePlugin) Render(ctx context.Context, width int, height int, scroll bool) ([]*Frame, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if scroll {
+		width *= 2
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{red}, image.Point{}, draw.Src)
+
+	if f.frames < 1 {
+		return []*Frame{
+			{
+				Image:    img,
+				Duration: time.Millisecond,
+			},
+		}, nil
+	}
+
+	frames := make([]*Frame, 0, f.frames)
+	for i := 0; i < f.frames; i++ {
+		frames = append(frames, &Frame{
+			Image:    img,
+			Duration: 20 * time.Millisecond,
+		})
+	}
+
+	return frames, nil
+}
+
+func (f *fakePlugin) SetEnabled(enabled bool) {
+	f.enabled.Store(enabled)
+}
+
+func (f *fakePlugin) Status() *Status {
+	return &Status{
+		DataLoaded: time.Unix(1600000000, 0),
+	}
+}
+
+func socketBoard(t *testing.T, p Plugin) *PluginBoard {
+	dir, err := ioutil.TempDir("", "plugin")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	socket := filepath.Join(dir, "plugin.sock")
+	go func() {
+		_ = ServeSocket(ctx, socket, p)
+	}()
+
+	config := &Config{
+		Name:    "fake",
+		Socket:  socket,
+		Enabled: atomic.NewBool(true),
+	}
+	config.SetDefaults()
+
+	b, err := New(zap.NewNop(), config)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(socket)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return b
+}
+
+func TestRender(t *testing.T) {
+	p := &fakePlugin{
+		enabled: atomic.NewBool(false),
+	}
+	b := socketBoard(t, p)
+
+	img := boardtest.Render(t, b, image.Point{X: 64, Y: 32})
+	require.Equal(t, red, img.RGBAAt(10, 10))
+	require.True(t, p.enabled.Load())
+
+	require.Eventually(t, func() bool {
+		return b.Diagnostics().DataUpdated.Equal(time.Unix(1600000000, 0))
+	}, 5*time.Second, 10*time.Millisecond)
+
+	b.Disable()
+	require.Eventually(t, func() bool {
+		return !p.enabled.Load()
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestStatusWhileRendering(t *testing.T) {
+	b := socketBoard(t, &fakePlugin{
+		enabled: atomic.NewBool(false),
+		frames:  50,
+	})
+
+	rendered := make(chan error, 1)
+	go func() {
+		rendered <- b.Render(context.Background(), rgb.NewCanvas(rgb.NewMemoryMatrix(64, 32)))
+	}()
+
+	// The plugin sends its frames faster than they're shown, which mustn't hold up other requests
+	require.Eventually(t, func() bool {
+		b.Lock()
+		defer b.Unlock()
+		return b.client != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+
+	b.Lock()
+	c := b.client
+	b.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	status, err := c.status(ctx, &pluginpb.Request{
+		Req: &pluginpb.Request_Status{
+			Status: &pluginpb.StatusReq{},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1600000000), status.DataLoaded)
+
+	require.NoError(t, <-rendered)
+}
+
+func TestScrollRender(t *testing.T) {
+	b := socketBoard(t, &fakePlugin{
+		enabled: atomic.NewBool(false),
+	})
+
+	canvas, err := rgb.NewScrollCanvas(rgb.NewMemoryMatrix(64, 32), zap.NewNop())
+	require.NoError(t, err)
+
+	c, err := b.ScrollRender(context.Background(), canvas, 0)
+	require.NoError(t, err)
+
+	scrollCanvas, ok := c.(*rgb.ScrollCanvas)
+	require.True(t, ok)
+	require.Equal(t, 128, scrollCanvas.GetActual().Bounds().Dx())
+}
+
+func TestRenderError(t *testing.T) {
+	b := socketBoard(t, &fakePlugin{
+		enabled: atomic.NewBool(false),
+		err:     fmt.Errorf("no data"),
+	})
+
+	err := b.Render(context.Background(), rgb.NewCanvas(rgb.NewMemoryMatrix(64, 32)))
+	require.EqualError(t, err, "plugin error: no data")
+}
+
+// TestHelperPlugin isn't a real test. It's the plugin that TestCommand launches
+func TestHelperPlugin(t *testing.T) {
+	if os.Getenv("PLUGINBOARD_HELPER") != "1" {
+		return
+	}
+
+	_ = Serve(context.Background(), os.Stdin, os.Stdout, &fakePlugin{
+		enabled: atomic.NewBool(false),
+	})
+	os.Exit(0)
+}
+
+func TestCommand(t *testing.T) {
+	os.Setenv("PLUGINBOARD_HELPER", "1")
+	defer os.Unsetenv("PLUGINBOARD_HELPER")
+
+	config := &Config{
+		Name:    "helper",
+		Command: []string{os.Args[0], "-test.run=TestHelperPlugin"},
+		Enabled: atomic.NewBool(true),
+	}
+	config.SetDefaults()
+
+	b, err := New(zap.NewNop(), config)
+	require.NoError(t, err)
+
+	img := boardtest.Render(t, b, image.Point{X: 64, Y: 32})
+	require.Equal(t, red, img.RGBAAt(63, 31))
+
+	// The plugin is restarted after it exits
+	b.Lock()
+	b.client.fail(fmt.Errorf("killed"))
+	b.Unlock()
+	img = boardtest.Render(t, b, image.Point{X: 64, Y: 32})
+	require.Equal(t, red, img.RGBAAt(0, 0))
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(zap.NewNop(), &Config{Name: "my plugin", Socket: "/tmp/plugin.sock"})
+	require.EqualError(t, err, "invalid plugin name 'my plugin', must be letters, numbers, '-' or '_'")
+
+	_, err = New(zap.NewNop(), &Config{Name: "plugin"})
+	require.EqualError(t, err, "plugin plugin has neither a command nor a socket")
+}
@@ -0,0 +1,49 @@
+package pluginboard
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// maxMessageSize limits the size of a message, so a misbehaving plugin can't exhaust memory
+const maxMessageSize = 64 << 20
+
+// writeMessage writes a message prefixed with its length as a varint
+func writeMessage(w io.Writer, m proto.Message) error {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(data))
+	n := binary.PutUvarint(buf, uint64(len(data)))
+	buf = append(buf[:n], data...)
+
+	_, err = w.Write(buf)
+	return err
+}
+
+// readMessage reads a message written by writeMessage
+func readMessage(r *bufio.Reader, m proto.Message) error {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	if size > maxMessageSize {
+		return fmt.Errorf("message of %d bytes is larger than the maximum of %d", size, maxMessageSize)
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+
+	return proto.Unmarshal(data, m)
+}
@@ -0,0 +1,227 @@
+package pluginboard
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
+
+	pluginpb "github.com/robbydyer/sports/internal/proto/plugin"
+)
+
+// Plugin is implemented by plugin boards written in Go. See Serve
+type Plugin interface {
+	// Render returns the frames of one pass of the board, at the given size. In scroll mode,
+	// frames can be wider than the matrix, and are scrolled across it one after another
+	Render(ctx context.Context, width int, height int, scroll bool) ([]*Frame, error)
+	// SetEnabled is called when the board is enabled or disabled
+	SetEnabled(enabled bool)
+	// Status reports on the plugin's data
+	Status() *Status
+}
+
+// Frame is an image shown by a plugin board
+type Frame struct {
+	Image *image.RGBA
+	// Duration is how long the frame is shown. It defaults to the board's boardDelay
+	Duration time.Duration
+}
+
+// Status of a plugin's data
+type Status struct {
+	// DataLoaded is when the plugin last loaded its data
+	DataLoaded time.Time
+	// Skipped is why the plugin has nothing to show, if it doesn't
+	Skipped string
+}
+
+// pluginServer answers the requests of the matrix for a Plugin
+type pluginServer struct {
+	plugin  Plugin
+	w       io.Writer
+	enabled *atomic.Bool
+	sync.Mutex
+}
+
+// Serve answers the requests of the matrix for a plugin, until r is closed. Plugins that are
+// launched without a socket are talked to over their stdin and stdout, ie.
+//
+//	pluginboard.Serve(ctx, os.Stdin, os.Stdout, myPlugin)
+func Serve(ctx context.Context, r io.Reader, w io.Writer, p Plugin) error {
+	s := &pluginServer{
+		plugin:  p,
+		w:       w,
+		enabled: atomic.NewBool(false),
+	}
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	reader := bufio.NewReader(r)
+	for {
+		req := &pluginpb.Request{}
+		if err := readMessage(reader, req); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.handle(ctx, req)
+		}()
+	}
+}
+
+// ServeSocket listens on a unix socket, and serves each connection from the matrix in turn
+// until the context is canceled
+func ServeSocket(ctx context.Context, socket string, p Plugin) error {
+	if err := os.Remove(socket); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		err = Serve(ctx, conn, conn, p)
+		conn.Close()
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+	}
+}
+
+func (s *pluginServer) handle(ctx context.Context, req *pluginpb.Request) {
+	var err error
+	switch r := req.Req.(type) {
+	case *pluginpb.Request_Render:
+		err = s.render(ctx, req.Id, r.Render)
+	case *pluginpb.Request_SetEnabled:
+		s.enabled.Store(r.SetEnabled.Enabled)
+		s.plugin.SetEnabled(r.SetEnabled.Enabled)
+		s.sendStatus(req.Id)
+	case *pluginpb.Request_Status:
+		s.sendStatus(req.Id)
+	default:
+		err = fmt.Errorf("unsupported request %T", req.Req)
+	}
+
+	if err != nil {
+		s.send(&pluginpb.Response{
+			Id: req.Id,
+			Resp: &pluginpb.Response_Error{
+				Error: err.Error(),
+			},
+		})
+	}
+}
+
+func (s *pluginServer) render(ctx context.Context, id uint64, req *pluginpb.RenderReq) error {
+	frames, err := s.plugin.Render(ctx, int(req.Width), int(req.Height), req.Scroll)
+	if err != nil {
+		return err
+	}
+
+	if len(frames) == 0 {
+		s.send(&pluginpb.Response{
+			Id: id,
+			Resp: &pluginpb.Response_Frame{
+				Frame: &pluginpb.Frame{
+					Last: true,
+				},
+			},
+		})
+		return nil
+	}
+
+	for i, f := range frames {
+		frame := &pluginpb.Frame{
+			DurationMs: int32(f.Duration / time.Millisecond),
+			Last:       i == len(frames)-1,
+		}
+		if f.Image != nil {
+			bounds := f.Image.Bounds()
+			frame.Width = int32(bounds.Dx())
+			frame.Height = int32(bounds.Dy())
+			frame.Rgba = pixels(f.Image)
+		}
+
+		s.send(&pluginpb.Response{
+			Id: id,
+			Resp: &pluginpb.Response_Frame{
+				Frame: frame,
+			},
+		})
+	}
+
+	return nil
+}
+
+func (s *pluginServer) sendStatus(id uint64) {
+	status := &pluginpb.Status{
+		Enabled: s.enabled.Load(),
+	}
+	if st := s.plugin.Status(); st != nil {
+		if !st.DataLoaded.IsZero() {
+			status.DataLoaded = st.DataLoaded.Unix()
+		}
+		status.Skipped = st.Skipped
+	}
+
+	s.send(&pluginpb.Response{
+		Id: id,
+		Resp: &pluginpb.Response_Status{
+			Status: status,
+		},
+	})
+}
+
+// send writes a response. Write errors are dropped, as the matrix has gone away, and Serve
+// returns once its end of the connection is closed
+func (s *pluginServer) send(resp proto.Message) {
+	s.Lock()
+	defer s.Unlock()
+	_ = writeMessage(s.w, resp)
+}
+
+// pixels returns an image's pixels, row by row with no padding
+func pixels(img *image.RGBA) []byte {
+	bounds := img.Bounds()
+	if bounds.Min == (image.Point{}) && img.Stride == bounds.Dx()*4 {
+		return img.Pix[:bounds.Dx()*bounds.Dy()*4]
+	}
+
+	packed := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(packed, packed.Bounds(), img, bounds.Min, draw.Src)
+
+	return packed.Pix
+}
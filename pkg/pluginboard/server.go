@@ -0,0 +1,56 @@
+package pluginboard
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+	"github.com/robbydyer/sports/pkg/board"
+)
+
+// Server ...
+type Server struct {
+	board *PluginBoard
+}
+
+// GetRPCHandler ...
+func (p *PluginBoard) GetRPCHandler() (string, http.Handler) {
+	return p.rpcServer.PathPrefix(), p.rpcServer
+}
+
+// SetStatus ...
+func (s *Server) SetStatus(ctx context.Context, req *pb.SetStatusReq) (*emptypb.Empty, error) {
+	if req.Status == nil {
+		return &emptypb.Empty{}, twirp.NewError(twirp.InvalidArgument, "nil status sent")
+	}
+
+	if req.Status.Enabled {
+		if s.board.Enable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
+		}
+	} else {
+		if s.board.Disable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
+		}
+	}
+	if s.board.config.ScrollMode.CAS(!req.Status.ScrollEnabled, req.Status.ScrollEnabled) {
+		board.RecordToggles(ctx, s.board.config.ScrollMode)
+		s.board.cancel()
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetStatus ...
+func (s *Server) GetStatus(ctx context.Context, req *emptypb.Empty) (*pb.StatusResp, error) {
+	return &pb.StatusResp{
+		Status: &pb.Status{
+			Enabled:       s.board.config.Enabled.Load(),
+			ScrollEnabled: s.board.config.ScrollMode.Load(),
+		},
+	}, nil
+}
@@ -8,7 +8,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/robfig/cron/v3"
 	"github.com/twitchtv/twirp"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -17,6 +16,7 @@ import (
 	"github.com/robbydyer/sports/pkg/logo"
 	"github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
 	"github.com/robbydyer/sports/pkg/rgbrender"
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/twirphelpers"
 	"github.com/robbydyer/sports/pkg/util"
 
@@ -44,7 +44,7 @@ type Todayer func() []time.Time
 
 // Config ...
 type Config struct {
-	TodayFunc          Todayer
+	TodayFunc          Todayer `json:"-"`
 	boardDelay         time.Duration
 	scrollDelay        time.Duration
 	Enabled            *atomic.Bool `json:"enabled"`
@@ -121,7 +121,7 @@ func New(api API, logger *zap.Logger, config *Config) (*RacingBoard, error) {
 		s.config.TodayFunc = util.Today
 	}
 
-	c := cron.New()
+	c := simclock.NewCron()
 
 	for _, on := range config.OnTimes {
 		s.log.Info("racingboard will be schedule to turn on",
@@ -187,6 +187,11 @@ func (s *RacingBoard) Enabled() bool {
 	return s.config.Enabled.Load()
 }
 
+// EnabledToggle ...
+func (s *RacingBoard) EnabledToggle() *atomic.Bool {
+	return s.config.Enabled
+}
+
 // Enable ...
 func (s *RacingBoard) Enable() bool {
 	if s.config.Enabled.CAS(false, true) {
@@ -225,7 +230,7 @@ func (s *RacingBoard) ScrollMode() bool {
 }
 
 // HasPriority ...
-func (s *RacingBoard) HasPriority() bool {
+func (s *RacingBoard) HasPriority(ctx context.Context) bool {
 	return false
 }
 
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	pb "github.com/robbydyer/sports/internal/proto/racingboard"
+	"github.com/robbydyer/sports/pkg/board"
 )
 
 // Server ...
@@ -23,14 +24,17 @@ func (s *Server) SetStatus(ctx context.Context, req *pb.SetStatusReq) (*emptypb.
 
 	if req.Status.Enabled {
 		if s.board.Enable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
 			cancelBoard = true
 		}
 	} else {
 		if s.board.Disable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
 			cancelBoard = true
 		}
 	}
 	if s.board.config.ScrollMode.CAS(!req.Status.ScrollEnabled, req.Status.ScrollEnabled) {
+		board.RecordToggles(ctx, s.board.config.ScrollMode)
 		cancelBoard = true
 	}
 
@@ -0,0 +1,147 @@
+package rgbmatrix
+
+import (
+	"image/color"
+	"io"
+	"sync"
+	"time"
+)
+
+// FileMatrix is a Matrix that writes frames as a raw stream of 24-bit RGB pixels, ie. to
+// a named pipe read by a video encoder. The latest frame is written at a constant frame
+// rate, so the stream has correct timing no matter how often the matrix is rendered
+type FileMatrix struct {
+	width    int
+	height   int
+	leds     []uint32
+	frame    []byte
+	out      io.WriteCloser
+	interval time.Duration
+	done     chan struct{}
+	err      error
+	sync.Mutex
+}
+
+// NewFileMatrix ...
+func NewFileMatrix(width int, height int, fps int, out io.WriteCloser) *FileMatrix {
+	if fps < 1 {
+		fps = 30
+	}
+	m := &FileMatrix{
+		width:    width,
+		height:   height,
+		leds:     make([]uint32, width*height),
+		frame:    make([]byte, width*height*3),
+		out:      out,
+		interval: time.Second / time.Duration(fps),
+		done:     make(chan struct{}),
+	}
+
+	go m.writeFrames()
+
+	return m
+}
+
+// Geometry ...
+func (m *FileMatrix) Geometry() (int, int) {
+	return m.width, m.height
+}
+
+// At ...
+func (m *FileMatrix) At(position int) color.Color {
+	m.Lock()
+	defer m.Unlock()
+	if position > len(m.leds)-1 || position < 0 {
+		return color.Black
+	}
+
+	return uint32ToColorGo(m.leds[position])
+}
+
+// Set ...
+func (m *FileMatrix) Set(position int, c color.Color) {
+	m.Lock()
+	defer m.Unlock()
+	if position > len(m.leds)-1 || position < 0 {
+		return
+	}
+
+	m.leds[position] = colorToUint32(c)
+}
+
+// Apply ...
+func (m *FileMatrix) Apply(leds []color.Color) error {
+	for position, c := range leds {
+		m.Set(position, c)
+	}
+
+	return m.Render()
+}
+
+// Render makes the current buffer the frame that is written to the stream
+func (m *FileMatrix) Render() error {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.err != nil {
+		return m.err
+	}
+
+	for i, l := range m.leds {
+		m.frame[i*3] = byte(l >> 16)
+		m.frame[(i*3)+1] = byte(l >> 8)
+		m.frame[(i*3)+2] = byte(l)
+	}
+
+	m.leds = make([]uint32, m.width*m.height)
+
+	return nil
+}
+
+// Close stops writing frames and closes the output
+func (m *FileMatrix) Close() error {
+	select {
+	case <-m.done:
+		return nil
+	default:
+	}
+	close(m.done)
+
+	m.Lock()
+	defer m.Unlock()
+	return m.out.Close()
+}
+
+// SetBrightness does nothing
+func (m *FileMatrix) SetBrightness(brightness int) {}
+
+func (m *FileMatrix) writeFrames() {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	frame := make([]byte, len(m.frame))
+
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+		}
+
+		// Writing to a pipe blocks until the reader catches up, so write a copy of
+		// the frame without holding the lock that rendering needs
+		m.Lock()
+		if m.err != nil {
+			m.Unlock()
+			continue
+		}
+		copy(frame, m.frame)
+		m.Unlock()
+
+		if _, err := m.out.Write(frame); err != nil {
+			m.Lock()
+			m.err = err
+			m.Unlock()
+		}
+	}
+}
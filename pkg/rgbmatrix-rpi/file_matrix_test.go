@@ -0,0 +1,40 @@
+package rgbmatrix
+
+import (
+	"image/color"
+	"io"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type FileMatrixSuite struct{}
+
+var _ = Suite(&FileMatrixSuite{})
+
+func (s *FileMatrixSuite) TestRenderWhileWriteBlocks(c *C) {
+	r, w := io.Pipe()
+	m := NewFileMatrix(2, 1, 100, w)
+
+	// Nothing reads the pipe yet, so the first frame write blocks
+	time.Sleep(50 * time.Millisecond)
+
+	rendered := make(chan error)
+	go func() {
+		m.Set(0, color.RGBA{R: 255, A: 255})
+		rendered <- m.Render()
+	}()
+
+	select {
+	case err := <-rendered:
+		c.Assert(err, IsNil)
+	case <-time.After(time.Second):
+		c.Fatal("render blocked on a frame write")
+	}
+
+	frame := make([]byte, 6)
+	_, err := io.ReadFull(r, frame)
+	c.Assert(err, IsNil)
+
+	c.Assert(m.Close(), IsNil)
+}
@@ -71,6 +71,17 @@ var DefaultRuntimeOptions = RuntimeOptions{
 	DoGPIOInit:     true,
 }
 
+// HardwareMappings are the GPIO mappings supported by rpi-rgb-led-matrix, see lib/hardware-mapping.c
+var HardwareMappings = []string{
+	"regular",
+	"adafruit-hat",
+	"adafruit-hat-pwm",
+	"regular-pi1",
+	"classic",
+	"classic-pi1",
+	"compute-module",
+}
+
 // HardwareConfig rgb-led-matrix configuration
 type HardwareConfig struct {
 	// Rows the number of rows supported by the display, so 32 or 16.
@@ -0,0 +1,73 @@
+package rgbmatrix
+
+import (
+	"image/color"
+	"sync"
+)
+
+// MemoryMatrix is a Matrix that only keeps its pixels in memory. Wrapped in a RecordingMatrix, it
+// renders boards headless at any geometry
+type MemoryMatrix struct {
+	width  int
+	height int
+	leds   []uint32
+	sync.Mutex
+}
+
+// NewMemoryMatrix ...
+func NewMemoryMatrix(width int, height int) *MemoryMatrix {
+	return &MemoryMatrix{
+		width:  width,
+		height: height,
+		leds:   make([]uint32, width*height),
+	}
+}
+
+// Geometry ...
+func (m *MemoryMatrix) Geometry() (int, int) {
+	return m.width, m.height
+}
+
+// At ...
+func (m *MemoryMatrix) At(position int) color.Color {
+	m.Lock()
+	defer m.Unlock()
+	if position > len(m.leds)-1 || position < 0 {
+		return color.Black
+	}
+
+	return uint32ToColorGo(m.leds[position])
+}
+
+// Set ...
+func (m *MemoryMatrix) Set(position int, c color.Color) {
+	m.Lock()
+	defer m.Unlock()
+	if position > len(m.leds)-1 || position < 0 {
+		return
+	}
+
+	m.leds[position] = colorToUint32(c)
+}
+
+// Apply ...
+func (m *MemoryMatrix) Apply(leds []color.Color) error {
+	for position, c := range leds {
+		m.Set(position, c)
+	}
+
+	return m.Render()
+}
+
+// Render ...
+func (m *MemoryMatrix) Render() error {
+	return nil
+}
+
+// Close ...
+func (m *MemoryMatrix) Close() error {
+	return nil
+}
+
+// SetBrightness does nothing
+func (m *MemoryMatrix) SetBrightness(brightness int) {}
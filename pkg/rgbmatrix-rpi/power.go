@@ -0,0 +1,174 @@
+package rgbmatrix
+
+import (
+	"fmt"
+	"image/color"
+	"sync"
+)
+
+const (
+	defaultLEDMilliamps = 20
+	maxBrightness       = 100
+)
+
+// PowerLimit configures the estimated current budget for a PowerLimitMatrix
+type PowerLimit struct {
+	// MaxMilliamps is the most current the panels may draw
+	MaxMilliamps float64 `json:"maxMilliamps"`
+	// LEDMilliamps is the current drawn by a single red, green or blue LED at full brightness
+	LEDMilliamps float64 `json:"ledMilliamps"`
+	// Panels is the number of panels in the matrix
+	Panels int `json:"panels"`
+	// PanelMilliamps is the current drawn by each panel when all LEDs are off
+	PanelMilliamps float64 `json:"panelMilliamps"`
+}
+
+// PowerLimitMatrix estimates the current drawn by each frame, and dims frames that would
+// go over the budget before rendering them to the Matrix it wraps
+type PowerLimitMatrix struct {
+	Matrix
+	limit      *PowerLimit
+	brightness int
+	estimate   float64
+	limited    bool
+	sync.Mutex
+}
+
+// SetDefaults ...
+func (p *PowerLimit) SetDefaults(panels int) {
+	if p.LEDMilliamps == 0 {
+		p.LEDMilliamps = defaultLEDMilliamps
+	}
+	if p.Panels == 0 {
+		p.Panels = panels
+	}
+}
+
+// Validate ...
+func (p *PowerLimit) Validate() error {
+	if p.LEDMilliamps < 0 || p.PanelMilliamps < 0 {
+		return fmt.Errorf("power limit milliamps can't be negative")
+	}
+	if p.MaxMilliamps <= float64(p.Panels)*p.PanelMilliamps {
+		return fmt.Errorf("power limit of %.0fmA must be more than the %.0fmA drawn by %d idle panels",
+			p.MaxMilliamps, float64(p.Panels)*p.PanelMilliamps, p.Panels,
+		)
+	}
+
+	return nil
+}
+
+// NewPowerLimitMatrix ...
+func NewPowerLimitMatrix(m Matrix, limit *PowerLimit, brightness int) *PowerLimitMatrix {
+	return &PowerLimitMatrix{
+		Matrix:     m,
+		limit:      limit,
+		brightness: brightness,
+	}
+}
+
+// Render dims the frame if it's estimated to draw more than the budget, then renders
+func (m *PowerLimitMatrix) Render() error {
+	m.Lock()
+	defer m.Unlock()
+
+	w, h := m.Geometry()
+	leds := make([]color.Color, w*h)
+	for i := range leds {
+		leds[i] = m.At(i)
+	}
+
+	idle := float64(m.limit.Panels) * m.limit.PanelMilliamps
+	perLED := m.limit.LEDMilliamps * float64(m.brightness) / maxBrightness
+	draw := idle + perLED*channelSum(leds)
+
+	m.estimate = draw
+	m.limited = draw > m.limit.MaxMilliamps
+	if !m.limited {
+		return m.Matrix.Render()
+	}
+
+	scale := (m.limit.MaxMilliamps - idle) / (draw - idle)
+	m.estimate = m.limit.MaxMilliamps
+
+	scaled := make([]color.Color, len(leds))
+	for i, c := range leds {
+		scaled[i] = scaleColor(c, scale)
+		m.Matrix.Set(i, scaled[i])
+	}
+
+	if err := m.Matrix.Render(); err != nil {
+		return err
+	}
+
+	// Put back the original frame, unless the Matrix cleared its buffer when rendering
+	for i, c := range scaled {
+		if sameColor(m.Matrix.At(i), c) {
+			m.Matrix.Set(i, leds[i])
+		}
+	}
+
+	return nil
+}
+
+// Apply sets all the pixels of the Matrix and renders
+func (m *PowerLimitMatrix) Apply(leds []color.Color) error {
+	for position, l := range leds {
+		m.Set(position, l)
+	}
+
+	return m.Render()
+}
+
+// SetBrightness sets the brightness of the wrapped Matrix, which is accounted for in the estimate
+func (m *PowerLimitMatrix) SetBrightness(brightness int) {
+	m.Lock()
+	m.brightness = brightness
+	m.Unlock()
+
+	m.Matrix.SetBrightness(brightness)
+}
+
+// Estimate returns the estimated current drawn by the last frame in milliamps, and
+// whether the frame was dimmed to stay within the budget
+func (m *PowerLimitMatrix) Estimate() (float64, bool) {
+	m.Lock()
+	defer m.Unlock()
+	return m.estimate, m.limited
+}
+
+// channelSum returns the sum of every red, green and blue value, where 1 is fully on
+func channelSum(leds []color.Color) float64 {
+	var sum uint64
+	for _, c := range leds {
+		if c == nil {
+			continue
+		}
+		r, g, b, _ := c.RGBA()
+		sum += uint64(r>>8) + uint64(g>>8) + uint64(b>>8)
+	}
+
+	return float64(sum) / 255
+}
+
+func scaleColor(c color.Color, scale float64) color.Color {
+	if c == nil {
+		return color.Black
+	}
+	r, g, b, _ := c.RGBA()
+	return color.RGBA{
+		R: uint8(float64(r>>8) * scale),
+		G: uint8(float64(g>>8) * scale),
+		B: uint8(float64(b>>8) * scale),
+		A: 0xff,
+	}
+}
+
+func sameColor(a color.Color, b color.Color) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	ar, ag, ab, aa := a.RGBA()
+	br, bg, bb, ba := b.RGBA()
+	return ar == br && ag == bg && ab == bb && aa == ba
+}
@@ -0,0 +1,55 @@
+package rgbmatrix
+
+import (
+	"image/color"
+
+	. "gopkg.in/check.v1"
+)
+
+type PowerSuite struct{}
+
+var _ = Suite(&PowerSuite{})
+
+func (s *PowerSuite) TestPowerLimit(c *C) {
+	limit := &PowerLimit{
+		MaxMilliamps:   400,
+		PanelMilliamps: 100,
+	}
+	limit.SetDefaults(1)
+	c.Assert(limit.Validate(), IsNil)
+
+	buf := newBufferMatrix(4, 2)
+	m := NewPowerLimitMatrix(buf, limit, 100)
+
+	// 2 white pixels draw 100 + 2*3*20mA
+	m.Set(0, color.White)
+	m.Set(1, color.White)
+	c.Assert(m.Render(), IsNil)
+	milliamps, limited := m.Estimate()
+	c.Assert(milliamps, Equals, 220.0)
+	c.Assert(limited, Equals, false)
+	c.Assert(buf.rendered[0], Equals, color.White)
+
+	// 8 white pixels would draw 580mA, so the LEDs are dimmed to 300/480ths to fit
+	for i := 0; i < 8; i++ {
+		m.Set(i, color.White)
+	}
+	c.Assert(m.Render(), IsNil)
+	milliamps, limited = m.Estimate()
+	c.Assert(milliamps, Equals, 400.0)
+	c.Assert(limited, Equals, true)
+	c.Assert(buf.rendered[7], Equals, color.RGBA{159, 159, 159, 255})
+
+	// Lower brightness draws less
+	m.SetBrightness(50)
+	for i := 0; i < 8; i++ {
+		m.Set(i, color.White)
+	}
+	c.Assert(m.Render(), IsNil)
+	milliamps, limited = m.Estimate()
+	c.Assert(milliamps, Equals, 340.0)
+	c.Assert(limited, Equals, false)
+
+	limit.MaxMilliamps = 50
+	c.Assert(limit.Validate(), NotNil)
+}
@@ -0,0 +1,255 @@
+package rgbmatrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"sync"
+	"time"
+)
+
+// Frame is a frame that was rendered to a Matrix, and how long it was shown
+type Frame struct {
+	Image *image.RGBA
+	Delay time.Duration
+}
+
+// RecordingMatrix is a Matrix that can capture the frames rendered to the Matrix it wraps
+type RecordingMatrix struct {
+	Matrix
+	recording *recording
+	sync.Mutex
+}
+
+type recording struct {
+	frames    []*image.RGBA
+	times     []time.Time
+	maxFrames int
+	stop      chan struct{}
+	stopOnce  sync.Once
+}
+
+// ErrRecordingFull is returned, along with the frames, by a recording that stopped
+// because it captured the most frames it was allowed to
+var ErrRecordingFull = errors.New("recording stopped at its frame limit")
+
+// NewRecordingMatrix ...
+func NewRecordingMatrix(m Matrix) *RecordingMatrix {
+	return &RecordingMatrix{
+		Matrix: m,
+	}
+}
+
+// Render captures the frame if recording, then renders to the wrapped Matrix
+func (m *RecordingMatrix) Render() error {
+	m.Lock()
+	if m.recording != nil {
+		m.recording.add(m.frame(), time.Now())
+	}
+	m.Unlock()
+
+	return m.Matrix.Render()
+}
+
+// Apply sets all the pixels of the Matrix and renders
+func (m *RecordingMatrix) Apply(leds []color.Color) error {
+	for position, l := range leds {
+		m.Set(position, l)
+	}
+
+	return m.Render()
+}
+
+// Record captures frames until the duration passes, the context is canceled, StopRecording is
+// called or maxFrames frames were captured. A duration of 0 records until stopped, and a maxFrames
+// of 0 doesn't limit the frames. ErrRecordingFull is returned with the frames if it hit the limit
+func (m *RecordingMatrix) Record(ctx context.Context, d time.Duration, maxFrames int) ([]*Frame, error) {
+	done, err := m.StartRecording(ctx, d, maxFrames)
+	if err != nil {
+		return nil, err
+	}
+
+	frames := <-done
+	if maxFrames > 0 && len(frames) >= maxFrames {
+		return frames, ErrRecordingFull
+	}
+
+	return frames, nil
+}
+
+// StartRecording starts capturing frames in the background, so that nothing rendered after it
+// returns is missed. The frames are sent on the returned channel when the recording stops, as
+// it does for Record
+func (m *RecordingMatrix) StartRecording(ctx context.Context, d time.Duration, maxFrames int) (<-chan []*Frame, error) {
+	m.Lock()
+	if m.recording != nil {
+		m.Unlock()
+		return nil, fmt.Errorf("a recording is already in progress")
+	}
+	r := &recording{
+		maxFrames: maxFrames,
+		stop:      make(chan struct{}),
+	}
+	m.recording = r
+	m.Unlock()
+
+	var timer *time.Timer
+	var timeout <-chan time.Time
+	if d > 0 {
+		timer = time.NewTimer(d)
+		timeout = timer.C
+	}
+
+	done := make(chan []*Frame, 1)
+	go func() {
+		if timer != nil {
+			defer timer.Stop()
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-timeout:
+		case <-r.stop:
+		}
+
+		m.Lock()
+		m.recording = nil
+		m.Unlock()
+
+		done <- r.result(time.Now())
+	}()
+
+	return done, nil
+}
+
+// StopRecording stops the recording in progress. Returns false if nothing was recording
+func (m *RecordingMatrix) StopRecording() bool {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.recording == nil {
+		return false
+	}
+	m.recording.end()
+
+	return true
+}
+
+// Recording returns whether a recording is in progress
+func (m *RecordingMatrix) Recording() bool {
+	m.Lock()
+	defer m.Unlock()
+	return m.recording != nil
+}
+
+func (m *RecordingMatrix) frame() *image.RGBA {
+	w, h := m.Geometry()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := m.At(x + (y * w))
+			if c == nil {
+				c = color.Black
+			}
+			// The matrix doesn't keep alpha, so frames are opaque
+			r, g, b, _ := c.RGBA()
+			img.SetRGBA(x, y, color.RGBA{uint8(r >> 8), uint8(g >> 8), uint8(b >> 8), 255})
+		}
+	}
+
+	return img
+}
+
+func (r *recording) add(img *image.RGBA, t time.Time) {
+	if r.maxFrames > 0 && len(r.frames) >= r.maxFrames {
+		return
+	}
+	// Unchanged frames just extend how long the previous frame is shown
+	if len(r.frames) > 0 && samePix(r.frames[len(r.frames)-1], img) {
+		return
+	}
+	r.frames = append(r.frames, img)
+	r.times = append(r.times, t)
+
+	// Frames are kept in memory until the recording stops, so it can't grow without a limit
+	if r.maxFrames > 0 && len(r.frames) >= r.maxFrames {
+		r.end()
+	}
+}
+
+func (r *recording) end() {
+	r.stopOnce.Do(func() {
+		close(r.stop)
+	})
+}
+
+func (r *recording) result(end time.Time) []*Frame {
+	frames := make([]*Frame, 0, len(r.frames))
+	for i, img := range r.frames {
+		next := end
+		if i+1 < len(r.times) {
+			next = r.times[i+1]
+		}
+		frames = append(frames, &Frame{
+			Image: img,
+			Delay: next.Sub(r.times[i]),
+		})
+	}
+
+	return frames
+}
+
+func samePix(a *image.RGBA, b *image.RGBA) bool {
+	if len(a.Pix) != len(b.Pix) {
+		return false
+	}
+	for i := range a.Pix {
+		if a.Pix[i] != b.Pix[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FramesToGif converts recorded frames to a GIF, keeping the timing of the frames as close
+// as the GIF's 10ms resolution allows
+func FramesToGif(frames []*Frame, scale int) *gif.GIF {
+	g := &gif.GIF{}
+
+	var elapsed time.Duration
+	for _, f := range frames {
+		start := int(elapsed / (10 * time.Millisecond))
+		elapsed += f.Delay
+		delay := int(elapsed/(10*time.Millisecond)) - start
+
+		// Frames shown for less than the GIF's resolution are dropped
+		if delay < 1 {
+			continue
+		}
+
+		bounds := f.Image.Bounds()
+		scaled := image.Rect(0, 0, bounds.Dx()*scale, bounds.Dy()*scale)
+
+		pal := image.NewPaletted(scaled, palette.Plan9)
+		if scale == 1 {
+			draw.Draw(pal, scaled, f.Image, bounds.Min, draw.Src)
+		} else {
+			for x := 0; x < scaled.Dx(); x++ {
+				for y := 0; y < scaled.Dy(); y++ {
+					pal.Set(x, y, f.Image.At(bounds.Min.X+(x/scale), bounds.Min.Y+(y/scale)))
+				}
+			}
+		}
+
+		g.Image = append(g.Image, pal)
+		g.Delay = append(g.Delay, delay)
+	}
+
+	return g
+}
@@ -0,0 +1,97 @@
+package rgbmatrix
+
+import (
+	"context"
+	"image/color"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type RecorderSuite struct{}
+
+var _ = Suite(&RecorderSuite{})
+
+func (s *RecorderSuite) TestRecord(c *C) {
+	m := NewRecordingMatrix(newBufferMatrix(4, 2))
+
+	c.Assert(m.StopRecording(), Equals, false)
+
+	done := make(chan []*Frame)
+	go func() {
+		frames, err := m.Record(context.Background(), 0, 0)
+		c.Assert(err, IsNil)
+		done <- frames
+	}()
+
+	for !m.Recording() {
+		time.Sleep(time.Millisecond)
+	}
+
+	_, err := m.Record(context.Background(), 0, 0)
+	c.Assert(err, NotNil)
+
+	m.Set(0, color.White)
+	c.Assert(m.Render(), IsNil)
+	m.Set(0, color.White)
+	c.Assert(m.Render(), IsNil)
+	m.Set(1, color.White)
+	c.Assert(m.Render(), IsNil)
+
+	c.Assert(m.StopRecording(), Equals, true)
+
+	frames := <-done
+
+	// The unchanged frame is dropped
+	c.Assert(frames, HasLen, 2)
+	c.Assert(frames[0].Image.At(0, 0), Equals, color.RGBA{255, 255, 255, 255})
+	c.Assert(frames[1].Image.At(1, 0), Equals, color.RGBA{255, 255, 255, 255})
+	c.Assert(m.Recording(), Equals, false)
+}
+
+func (s *RecorderSuite) TestRecordMemoryMatrix(c *C) {
+	m := NewRecordingMatrix(NewMemoryMatrix(4, 2))
+	canvas := NewCanvas(m)
+
+	done, err := m.StartRecording(context.Background(), 0, 0)
+	c.Assert(err, IsNil)
+	c.Assert(m.Recording(), Equals, true)
+
+	canvas.Set(3, 1, color.RGBA{255, 0, 0, 255})
+	c.Assert(canvas.Render(context.Background()), IsNil)
+	c.Assert(m.StopRecording(), Equals, true)
+
+	frames := <-done
+
+	// The matrix doesn't keep alpha, but frames are opaque so they save to PNGs as rendered
+	c.Assert(frames, HasLen, 1)
+	c.Assert(frames[0].Image.At(3, 1), Equals, color.RGBA{255, 0, 0, 255})
+	c.Assert(frames[0].Image.At(0, 0), Equals, color.RGBA{0, 0, 0, 255})
+}
+
+func (s *RecorderSuite) TestRecordMaxFrames(c *C) {
+	m := NewRecordingMatrix(newBufferMatrix(4, 2))
+
+	done := make(chan []*Frame)
+	go func() {
+		frames, err := m.Record(context.Background(), 0, 2)
+		c.Assert(err, Equals, ErrRecordingFull)
+		done <- frames
+	}()
+
+	for !m.Recording() {
+		time.Sleep(time.Millisecond)
+	}
+
+	for i := 0; i < 3; i++ {
+		m.Set(i, color.White)
+		c.Assert(m.Render(), IsNil)
+	}
+
+	// The recording stops itself at the limit
+	frames := <-done
+	c.Assert(frames, HasLen, 2)
+	c.Assert(frames[1].Image.At(1, 0), Equals, color.RGBA{255, 255, 255, 255})
+	c.Assert(m.Recording(), Equals, false)
+	c.Assert(m.StopRecording(), Equals, false)
+}
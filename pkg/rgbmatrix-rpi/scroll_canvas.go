@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/robbydyer/sports/pkg/board"
+	"github.com/robbydyer/sports/pkg/metrics"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
@@ -17,6 +18,10 @@ import (
 var (
 	black              = color.RGBA{R: 0x0, G: 0x0, B: 0x0, A: 0x0}
 	DefaultScrollDelay = 50 * time.Millisecond
+	scrollFrameSeconds = metrics.NewHistogram("sportsmatrix_scroll_frame_duration_seconds",
+		"Time to draw and render each frame of a scroll, not including the scroll delay",
+		[]float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25},
+	)
 )
 
 // ScrollDirection represents the direction the canvas scrolls
@@ -357,6 +362,7 @@ func (c *ScrollCanvas) rightToLeft(ctx context.Context) error {
 			return context.Canceled
 		case <-time.After(c.interval):
 		}
+		frameStart := time.Now()
 		if thisX == finish {
 			return nil
 		}
@@ -373,6 +379,7 @@ func (c *ScrollCanvas) rightToLeft(ctx context.Context) error {
 		if err := c.Matrix.Render(); err != nil {
 			return err
 		}
+		scrollFrameSeconds.Observe(time.Since(frameStart).Seconds())
 		thisX--
 	}
 }
@@ -404,6 +411,7 @@ func (c *ScrollCanvas) rightToLeftNoMerge(ctx context.Context) error {
 			return context.Canceled
 		case <-time.After(c.interval):
 		}
+		frameStart := time.Now()
 		if virtualX == finish {
 			return nil
 		}
@@ -420,6 +428,7 @@ func (c *ScrollCanvas) rightToLeftNoMerge(ctx context.Context) error {
 		if err := c.Matrix.Render(); err != nil {
 			return err
 		}
+		scrollFrameSeconds.Observe(time.Since(frameStart).Seconds())
 
 		pctDone = float64(virtualX) / float64(finish)
 
@@ -559,6 +568,7 @@ func (c *ScrollCanvas) topToBottom(ctx context.Context) error {
 			return context.Canceled
 		case <-time.After(c.interval):
 		}
+		frameStart := time.Now()
 		if thisY == c.actual.Bounds().Max.Y {
 			return nil
 		}
@@ -575,6 +585,7 @@ func (c *ScrollCanvas) topToBottom(ctx context.Context) error {
 		if err := c.Matrix.Render(); err != nil {
 			return err
 		}
+		scrollFrameSeconds.Observe(time.Since(frameStart).Seconds())
 		thisY++
 	}
 }
@@ -592,6 +603,7 @@ func (c *ScrollCanvas) bottomToTop(ctx context.Context) error {
 			return context.Canceled
 		case <-time.After(c.interval):
 		}
+		frameStart := time.Now()
 		if thisY == finish {
 			return nil
 		}
@@ -608,6 +620,7 @@ func (c *ScrollCanvas) bottomToTop(ctx context.Context) error {
 		if err := c.Matrix.Render(); err != nil {
 			return err
 		}
+		scrollFrameSeconds.Observe(time.Since(frameStart).Seconds())
 		thisY--
 	}
 }
@@ -0,0 +1,128 @@
+package rgbmatrix
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"sync"
+)
+
+// ZoneMatrix is a Matrix for a rectangular region of another Matrix. This allows
+// independent canvases to render to different regions of the same physical matrix.
+// Each zone keeps its own LED buffer, and rendering any zone renders the latest
+// frame of every zone
+type ZoneMatrix struct {
+	bounds image.Rectangle
+	leds   []color.Color
+	group  *zoneGroup
+}
+
+type zoneGroup struct {
+	parent Matrix
+	width  int
+	zones  []*ZoneMatrix
+	sync.Mutex
+}
+
+// NewZoneMatrices splits a Matrix into zones with the given bounds
+func NewZoneMatrices(parent Matrix, zones ...image.Rectangle) ([]*ZoneMatrix, error) {
+	w, h := parent.Geometry()
+	full := image.Rect(0, 0, w, h)
+
+	group := &zoneGroup{
+		parent: parent,
+		width:  w,
+	}
+
+	for _, bounds := range zones {
+		if bounds.Empty() || !bounds.In(full) {
+			return nil, fmt.Errorf("zone %s does not fit in matrix %s", bounds, full)
+		}
+		for _, z := range group.zones {
+			if z.bounds.Overlaps(bounds) {
+				return nil, fmt.Errorf("zone %s overlaps zone %s", bounds, z.bounds)
+			}
+		}
+		z := &ZoneMatrix{
+			bounds: bounds,
+			leds:   make([]color.Color, bounds.Dx()*bounds.Dy()),
+			group:  group,
+		}
+		for i := range z.leds {
+			z.leds[i] = color.Black
+		}
+		group.zones = append(group.zones, z)
+	}
+
+	return group.zones, nil
+}
+
+// Bounds returns the region of the parent matrix for this zone
+func (z *ZoneMatrix) Bounds() image.Rectangle {
+	return z.bounds
+}
+
+// Geometry returns the width and the height of the zone
+func (z *ZoneMatrix) Geometry() (width, height int) {
+	return z.bounds.Dx(), z.bounds.Dy()
+}
+
+// At returns the color at the given position in the zone
+func (z *ZoneMatrix) At(position int) color.Color {
+	z.group.Lock()
+	defer z.group.Unlock()
+	if position > len(z.leds)-1 || position < 0 {
+		return color.Black
+	}
+	return z.leds[position]
+}
+
+// Set sets the color at the given position in the zone
+func (z *ZoneMatrix) Set(position int, c color.Color) {
+	z.group.Lock()
+	defer z.group.Unlock()
+	if position > len(z.leds)-1 || position < 0 {
+		return
+	}
+	z.leds[position] = c
+}
+
+// Apply sets all the pixels of the zone and renders
+func (z *ZoneMatrix) Apply(leds []color.Color) error {
+	for position, l := range leds {
+		z.Set(position, l)
+	}
+
+	return z.Render()
+}
+
+// Render renders every zone to the parent matrix
+func (z *ZoneMatrix) Render() error {
+	return z.group.render()
+}
+
+// Close does nothing. The parent matrix must be closed separately
+func (z *ZoneMatrix) Close() error {
+	return nil
+}
+
+// SetBrightness sets the brightness of the parent matrix
+func (z *ZoneMatrix) SetBrightness(brightness int) {
+	z.group.parent.SetBrightness(brightness)
+}
+
+func (g *zoneGroup) render() error {
+	g.Lock()
+	defer g.Unlock()
+
+	for _, z := range g.zones {
+		w := z.bounds.Dx()
+		for i, c := range z.leds {
+			x := z.bounds.Min.X + (i % w)
+			y := z.bounds.Min.Y + (i / w)
+			g.parent.Set(x+(y*g.width), c)
+		}
+	}
+
+	return g.parent.Render()
+}
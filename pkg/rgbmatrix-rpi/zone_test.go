@@ -0,0 +1,98 @@
+package rgbmatrix
+
+import (
+	"context"
+	"image"
+	"image/color"
+
+	. "gopkg.in/check.v1"
+)
+
+type ZoneSuite struct{}
+
+var _ = Suite(&ZoneSuite{})
+
+type bufferMatrix struct {
+	w, h     int
+	leds     []color.Color
+	rendered []color.Color
+}
+
+func newBufferMatrix(w, h int) *bufferMatrix {
+	return &bufferMatrix{
+		w:    w,
+		h:    h,
+		leds: make([]color.Color, w*h),
+	}
+}
+
+func (m *bufferMatrix) Geometry() (int, int) {
+	return m.w, m.h
+}
+
+func (m *bufferMatrix) At(position int) color.Color {
+	return m.leds[position]
+}
+
+func (m *bufferMatrix) Set(position int, c color.Color) {
+	m.leds[position] = c
+}
+
+func (m *bufferMatrix) Apply(leds []color.Color) error {
+	return nil
+}
+
+// Render behaves like the real matrix, which starts each frame from a blank buffer
+func (m *bufferMatrix) Render() error {
+	m.rendered = m.leds
+	m.leds = make([]color.Color, m.w*m.h)
+	return nil
+}
+
+func (m *bufferMatrix) Close() error {
+	return nil
+}
+
+func (m *bufferMatrix) SetBrightness(brightness int) {}
+
+func (s *ZoneSuite) TestNewZoneMatrices(c *C) {
+	m := newBufferMatrix(10, 10)
+
+	_, err := NewZoneMatrices(m, image.Rect(0, 0, 10, 11))
+	c.Assert(err, NotNil)
+
+	_, err = NewZoneMatrices(m, image.Rect(0, 0, 10, 6), image.Rect(0, 5, 10, 10))
+	c.Assert(err, NotNil)
+
+	zones, err := NewZoneMatrices(m, image.Rect(0, 0, 10, 6), image.Rect(0, 6, 10, 10))
+	c.Assert(err, IsNil)
+	c.Assert(zones, HasLen, 2)
+
+	w, h := zones[1].Geometry()
+	c.Assert(w, Equals, 10)
+	c.Assert(h, Equals, 4)
+}
+
+func (s *ZoneSuite) TestZoneRender(c *C) {
+	m := newBufferMatrix(10, 10)
+
+	zones, err := NewZoneMatrices(m, image.Rect(0, 0, 10, 6), image.Rect(0, 6, 10, 10))
+	c.Assert(err, IsNil)
+
+	top := NewCanvas(zones[0])
+	bottom := NewCanvas(zones[1])
+
+	c.Assert(top.Bounds(), Equals, image.Rect(0, 0, 10, 6))
+	c.Assert(bottom.Bounds(), Equals, image.Rect(0, 0, 10, 4))
+
+	top.Set(1, 1, color.White)
+	c.Assert(top.Render(context.Background()), IsNil)
+	c.Assert(m.rendered[11], Equals, color.White)
+
+	bottom.Set(2, 0, color.White)
+	c.Assert(bottom.Render(context.Background()), IsNil)
+
+	// Both zones are rendered, even though the parent's buffer was reset
+	c.Assert(m.rendered[11], Equals, color.White)
+	c.Assert(m.rendered[62], Equals, color.White)
+}
@@ -0,0 +1,141 @@
+package simclock
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Clock tells the time. Boards and cron schedules use it instead of the time package, so that
+// a simulated clock can play back a past day
+type Clock interface {
+	Now() time.Time
+	// RealTime returns the real time at which the clock will show t
+	RealTime(t time.Time) time.Time
+}
+
+// realClock is the system clock
+type realClock struct{}
+
+// Simulated is a Clock that starts at a given time when it's created, and runs at a multiple of real time
+type Simulated struct {
+	start     time.Time
+	realStart time.Time
+	speed     float64
+}
+
+var (
+	current Clock = realClock{}
+	lock    sync.RWMutex
+)
+
+// Now ...
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// RealTime ...
+func (realClock) RealTime(t time.Time) time.Time {
+	return t
+}
+
+// NewSimulated returns a Clock that shows start now, and runs speed times faster than real time
+func NewSimulated(start time.Time, speed float64) (*Simulated, error) {
+	if speed <= 0 {
+		return nil, fmt.Errorf("clock speed must be more than 0")
+	}
+
+	return &Simulated{
+		start:     start,
+		realStart: time.Now(),
+		speed:     speed,
+	}, nil
+}
+
+// Now ...
+func (s *Simulated) Now() time.Time {
+	return s.start.Add(time.Duration(float64(time.Since(s.realStart)) * s.speed))
+}
+
+// RealTime ...
+func (s *Simulated) RealTime(t time.Time) time.Time {
+	return s.realStart.Add(time.Duration(float64(t.Sub(s.start)) / s.speed))
+}
+
+// Set replaces the clock used by everything. It should be set before boards are created. A nil
+// Clock sets the real time
+func Set(c Clock) {
+	if c == nil {
+		c = realClock{}
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	current = c
+}
+
+// Get returns the current Clock
+func Get() Clock {
+	lock.RLock()
+	defer lock.RUnlock()
+	return current
+}
+
+// Now returns the current time on the clock
+func Now() time.Time {
+	return Get().Now()
+}
+
+// Since is like time.Since, on the clock
+func Since(t time.Time) time.Duration {
+	return Now().Sub(t)
+}
+
+// Until is like time.Until, on the clock
+func Until(t time.Time) time.Duration {
+	return t.Sub(Now())
+}
+
+// schedule runs a cron schedule on the clock. The cron scheduler itself runs in real time, so
+// each next run is converted to the real time the clock will show it
+type schedule struct {
+	cron.Schedule
+	last time.Time
+	sync.Mutex
+}
+
+// Next ...
+func (s *schedule) Next(realNow time.Time) time.Time {
+	s.Lock()
+	defer s.Unlock()
+
+	c := Get()
+	now := c.Now()
+	if _, ok := c.(realClock); ok {
+		now = realNow
+	}
+	// Converting between real and simulated time isn't exact, so make sure a run isn't repeated
+	if now.Before(s.last) {
+		now = s.last
+	}
+	s.last = s.Schedule.Next(now)
+
+	return c.RealTime(s.last)
+}
+
+type parser struct{}
+
+// Parse parses standard cron specs, scheduling them on the clock
+func (parser) Parse(spec string) (cron.Schedule, error) {
+	sched, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &schedule{Schedule: sched}, nil
+}
+
+// NewCron returns a cron scheduler that runs standard cron specs on the clock
+func NewCron(opts ...cron.Option) *cron.Cron {
+	return cron.New(append([]cron.Option{cron.WithParser(parser{})}, opts...)...)
+}
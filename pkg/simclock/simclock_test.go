@@ -0,0 +1,41 @@
+package simclock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestSimulated(t *testing.T) {
+	start := time.Date(2021, 10, 30, 11, 59, 0, 0, time.Local)
+	clock, err := NewSimulated(start, 600)
+	require.NoError(t, err)
+
+	Set(clock)
+	defer Set(nil)
+
+	require.WithinDuration(t, start, Now(), 10*time.Second)
+
+	// A simulated minute passes every 100ms
+	var runs atomic.Int32
+	c := NewCron()
+	_, err = c.AddFunc("* * * * *", func() { runs.Inc() })
+	require.NoError(t, err)
+	_, err = c.AddFunc("0 12 * * *", func() { runs.Add(100) })
+	require.NoError(t, err)
+	c.Start()
+	defer c.Stop()
+
+	require.Eventually(t, func() bool { return runs.Load() > 100 }, 2*time.Second, 10*time.Millisecond)
+	time.Sleep(250 * time.Millisecond)
+	c.Stop()
+	// 12:00 ran once, and each minute ran once
+	require.GreaterOrEqual(t, runs.Load(), int32(102))
+	require.LessOrEqual(t, runs.Load(), int32(106))
+	require.True(t, Now().After(start.Add(3*time.Minute)))
+
+	_, err = NewSimulated(start, 0)
+	require.Error(t, err)
+}
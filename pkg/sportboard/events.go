@@ -0,0 +1,252 @@
+package sportboard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/simclock"
+)
+
+// Game event types
+const (
+	GameStartEvent = "game_start"
+	ScoreEvent     = "score"
+	PeriodEndEvent = "period_end"
+	FinalEvent     = "final"
+)
+
+var gameEventInterval = time.Minute
+
+// GameEvent is a change in a favorite team's game
+type GameEvent struct {
+	Type        string    `json:"type"`
+	League      string    `json:"league"`
+	GameID      int       `json:"gameId"`
+	HomeTeam    string    `json:"homeTeam"`
+	AwayTeam    string    `json:"awayTeam"`
+	HomeScore   int       `json:"homeScore"`
+	AwayScore   int       `json:"awayScore"`
+	ScoringTeam string    `json:"scoringTeam,omitempty"`
+	Period      string    `json:"period"`
+	Clock       string    `json:"clock"`
+	Time        time.Time `json:"time"`
+}
+
+// GameEventHandler is called for each GameEvent
+type GameEventHandler func(event *GameEvent)
+
+type gameState struct {
+	live      bool
+	complete  bool
+	homeScore int
+	awayScore int
+	period    string
+}
+
+// Title describes the game, ie. "NYR @ BOS"
+func (e *GameEvent) Title() string {
+	return fmt.Sprintf("%s @ %s", e.AwayTeam, e.HomeTeam)
+}
+
+// Message describes the event, ie. "BOS scored! NYR 1 - BOS 2 (2nd 10:21)"
+func (e *GameEvent) Message() string {
+	score := fmt.Sprintf("%s %d - %s %d", e.AwayTeam, e.AwayScore, e.HomeTeam, e.HomeScore)
+
+	switch e.Type {
+	case GameStartEvent:
+		return fmt.Sprintf("%s has started", e.Title())
+	case ScoreEvent:
+		if e.Clock != "" {
+			return fmt.Sprintf("%s scored! %s (%s %s)", e.ScoringTeam, score, e.Period, e.Clock)
+		}
+		return fmt.Sprintf("%s scored! %s (%s)", e.ScoringTeam, score, e.Period)
+	case PeriodEndEvent:
+		return fmt.Sprintf("End of %s: %s", e.Period, score)
+	case FinalEvent:
+		return fmt.Sprintf("Final: %s", score)
+	}
+
+	return score
+}
+
+// WatchGameEvents polls today's games for favorite teams, calling the handler for each
+// game start, score change, period end and final. It blocks until the context is canceled
+func (s *SportBoard) WatchGameEvents(ctx context.Context, handler GameEventHandler) {
+	states := make(map[int]*gameState)
+
+	ticker := time.NewTicker(gameEventInterval)
+	defer ticker.Stop()
+
+	for {
+		if len(s.favoriteTeams()) > 0 {
+			if err := s.checkGameEvents(ctx, states, handler); err != nil {
+				s.log.Error("failed to check for game events",
+					zap.String("league", s.api.League()),
+					zap.Error(err),
+				)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *SportBoard) checkGameEvents(ctx context.Context, states map[int]*gameState, handler GameEventHandler) error {
+	games, err := s.api.GetScheduledGames(ctx, s.config.TodayFunc())
+	if err != nil {
+		return err
+	}
+
+	today := make(map[int]struct{}, len(games))
+
+GAMES:
+	for _, game := range games {
+		today[game.GetID()] = struct{}{}
+
+		isFavorite, err := s.isFavoriteGame(game)
+		if err != nil || !isFavorite {
+			continue GAMES
+		}
+
+		if prev, ok := states[game.GetID()]; ok && prev.complete {
+			continue GAMES
+		}
+
+		startTime, err := game.GetStartTime(ctx)
+		if err != nil {
+			return err
+		}
+		if simclock.Until(startTime) > 0 {
+			if _, ok := states[game.GetID()]; !ok {
+				states[game.GetID()] = &gameState{}
+			}
+			continue GAMES
+		}
+
+		liveGame, err := game.GetUpdate(ctx)
+		if err != nil {
+			return err
+		}
+		s.setCachedGame(game.GetID(), liveGame)
+
+		state, events, err := gameEvents(states[game.GetID()], liveGame, s.api.League())
+		if err != nil {
+			return err
+		}
+		states[game.GetID()] = state
+
+		for _, event := range events {
+			s.log.Info("game event",
+				zap.String("league", event.League),
+				zap.String("type", event.Type),
+				zap.String("game", event.Title()),
+			)
+			handler(event)
+		}
+	}
+
+	// Forget games that are no longer scheduled
+	for id := range states {
+		if _, ok := today[id]; !ok {
+			delete(states, id)
+		}
+	}
+
+	return nil
+}
+
+// gameEvents compares a game to its previous state. No events are returned the first time a game
+// is seen, so that restarting doesn't repeat events
+func gameEvents(prev *gameState, game Game, league string) (*gameState, []*GameEvent, error) {
+	home, err := game.HomeTeam()
+	if err != nil {
+		return nil, nil, err
+	}
+	away, err := game.AwayTeam()
+	if err != nil {
+		return nil, nil, err
+	}
+	live, err := game.IsLive()
+	if err != nil {
+		return nil, nil, err
+	}
+	complete, err := game.IsComplete()
+	if err != nil {
+		return nil, nil, err
+	}
+	period, err := game.GetQuarter()
+	if err != nil {
+		return nil, nil, err
+	}
+	clock, err := game.GetClock()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	state := &gameState{
+		live:      live,
+		complete:  complete,
+		homeScore: home.Score(),
+		awayScore: away.Score(),
+		period:    period,
+	}
+
+	if prev == nil {
+		return state, nil, nil
+	}
+
+	newEvent := func(typ string) *GameEvent {
+		return &GameEvent{
+			Type:      typ,
+			League:    league,
+			GameID:    game.GetID(),
+			HomeTeam:  home.GetAbbreviation(),
+			AwayTeam:  away.GetAbbreviation(),
+			HomeScore: state.homeScore,
+			AwayScore: state.awayScore,
+			Period:    period,
+			Clock:     clock,
+			Time:      simclock.Now(),
+		}
+	}
+
+	var events []*GameEvent
+
+	if !prev.live && !prev.complete && (live || complete) {
+		events = append(events, newEvent(GameStartEvent))
+	}
+
+	if state.awayScore > prev.awayScore {
+		e := newEvent(ScoreEvent)
+		e.ScoringTeam = e.AwayTeam
+		events = append(events, e)
+	}
+	if state.homeScore > prev.homeScore {
+		e := newEvent(ScoreEvent)
+		e.ScoringTeam = e.HomeTeam
+		events = append(events, e)
+	}
+
+	if complete {
+		if !prev.complete {
+			events = append(events, newEvent(FinalEvent))
+		}
+		return state, events, nil
+	}
+
+	if prev.live && prev.period != "" && period != prev.period {
+		e := newEvent(PeriodEndEvent)
+		e.Period = prev.period
+		e.Clock = ""
+		events = append(events, e)
+	}
+
+	return state, events, nil
+}
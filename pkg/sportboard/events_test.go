@@ -0,0 +1,104 @@
+package sportboard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testTeam struct {
+	abbrev string
+	score  int
+}
+
+func (t *testTeam) GetID() string           { return t.abbrev }
+func (t *testTeam) GetName() string         { return t.abbrev }
+func (t *testTeam) GetAbbreviation() string { return t.abbrev }
+func (t *testTeam) GetDisplayName() string  { return t.abbrev }
+func (t *testTeam) Score() int              { return t.score }
+func (t *testTeam) ConferenceName() string  { return "" }
+
+type testGame struct {
+	home     *testTeam
+	away     *testTeam
+	live     bool
+	complete bool
+	period   string
+	start    time.Time
+}
+
+func (g *testGame) GetID() int                                  { return 1 }
+func (g *testGame) GetLink() (string, error)                    { return "", nil }
+func (g *testGame) IsLive() (bool, error)                       { return g.live, nil }
+func (g *testGame) IsComplete() (bool, error)                   { return g.complete, nil }
+func (g *testGame) IsPostponed() (bool, error)                  { return false, nil }
+func (g *testGame) HomeTeam() (Team, error)                     { return g.home, nil }
+func (g *testGame) AwayTeam() (Team, error)                     { return g.away, nil }
+func (g *testGame) GetQuarter() (string, error)                 { return g.period, nil }
+func (g *testGame) GetClock() (string, error)                   { return "10:00", nil }
+func (g *testGame) GetUpdate(ctx context.Context) (Game, error) { return g, nil }
+func (g *testGame) GetOdds() (string, string, error)            { return "", "", nil }
+func (g *testGame) GetStartTime(ctx context.Context) (time.Time, error) {
+	if !g.start.IsZero() {
+		return g.start, nil
+	}
+	return time.Now(), nil
+}
+
+func TestGameEvents(t *testing.T) {
+	game := &testGame{
+		home: &testTeam{abbrev: "BOS"},
+		away: &testTeam{abbrev: "NYR"},
+	}
+
+	types := func(events []*GameEvent) []string {
+		var typs []string
+		for _, e := range events {
+			typs = append(typs, e.Type)
+		}
+		return typs
+	}
+
+	// The first time a game is seen doesn't generate events
+	state, events, err := gameEvents(nil, game, "NHL")
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	game.live = true
+	game.period = "1st"
+	state, events, err = gameEvents(state, game, "NHL")
+	require.NoError(t, err)
+	require.Equal(t, []string{GameStartEvent}, types(events))
+
+	game.home.score = 1
+	state, events, err = gameEvents(state, game, "NHL")
+	require.NoError(t, err)
+	require.Equal(t, []string{ScoreEvent}, types(events))
+	require.Equal(t, "BOS", events[0].ScoringTeam)
+	require.Equal(t, "BOS scored! NYR 0 - BOS 1 (1st 10:00)", events[0].Message())
+
+	state, events, err = gameEvents(state, game, "NHL")
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	game.period = "2nd"
+	state, events, err = gameEvents(state, game, "NHL")
+	require.NoError(t, err)
+	require.Equal(t, []string{PeriodEndEvent}, types(events))
+	require.Equal(t, "End of 1st: NYR 0 - BOS 1", events[0].Message())
+
+	game.away.score = 1
+	game.live = false
+	game.complete = true
+	game.period = "OT"
+	state, events, err = gameEvents(state, game, "NHL")
+	require.NoError(t, err)
+	require.Equal(t, []string{ScoreEvent, FinalEvent}, types(events))
+	require.Equal(t, "Final: NYR 1 - BOS 1", events[1].Message())
+
+	_, events, err = gameEvents(state, game, "NHL")
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
@@ -0,0 +1,131 @@
+package sportboard
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/boardtest"
+	"github.com/robbydyer/sports/pkg/logo"
+	"github.com/robbydyer/sports/pkg/simclock"
+)
+
+// goldenAPI serves a single game, with plain colored logos
+type goldenAPI struct {
+	game    *testGame
+	logoDir string
+}
+
+var teamColors = map[string]color.RGBA{
+	"BOS": {252, 181, 20, 255},
+	"NYR": {0, 56, 168, 255},
+}
+
+func (a *goldenAPI) GetTeams(ctx context.Context) ([]Team, error) {
+	return []Team{a.game.home, a.game.away}, nil
+}
+
+func (a *goldenAPI) TeamFromID(ctx context.Context, id string) (Team, error) {
+	for _, t := range []*testTeam{a.game.home, a.game.away} {
+		if t.abbrev == id {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("no team %s", id)
+}
+
+func (a *goldenAPI) GetScheduledGames(ctx context.Context, date []time.Time) ([]Game, error) {
+	return []Game{a.game}, nil
+}
+func (a *goldenAPI) DateStr(d time.Time) string { return d.Format("20060102") }
+func (a *goldenAPI) League() string             { return "NHL" }
+func (a *goldenAPI) HTTPPathPrefix() string     { return "nhl" }
+func (a *goldenAPI) GetWatchTeams(teams []string, season string) []string {
+	return []string{"BOS", "NYR"}
+}
+func (a *goldenAPI) TeamRecord(ctx context.Context, team Team, season string) string { return "10-2-1" }
+func (a *goldenAPI) TeamRank(ctx context.Context, team Team, season string) string   { return "" }
+func (a *goldenAPI) CacheClear(ctx context.Context)                                  {}
+
+// GetLogo returns a logo of the team's color with a white border, ie. BOS_HOME_64x32
+func (a *goldenAPI) GetLogo(ctx context.Context, logoKey string, logoConf *logo.Config, bounds image.Rectangle) (*logo.Logo, error) {
+	c := teamColors[logoKey[:3]]
+	getter := func(ctx context.Context) (image.Image, error) {
+		img := image.NewRGBA(image.Rect(0, 0, 64, 64))
+		draw.Draw(img, img.Bounds(), &image.Uniform{color.White}, image.Point{}, draw.Src)
+		draw.Draw(img, image.Rect(4, 4, 60, 60), &image.Uniform{c}, image.Point{}, draw.Src)
+		return img, nil
+	}
+
+	return logo.New(logoKey, getter, a.logoDir, bounds, logoConf), nil
+}
+
+func TestGolden(t *testing.T) {
+	start := time.Date(2021, time.October, 30, 19, 0, 0, 0, time.Local)
+	clock, err := simclock.NewSimulated(start.Add(-time.Hour), 1)
+	require.NoError(t, err)
+	simclock.Set(clock)
+	defer simclock.Set(nil)
+
+	tests := []struct {
+		name string
+		game *testGame
+	}{
+		{
+			name: "upcoming",
+			game: &testGame{},
+		},
+		{
+			name: "live",
+			game: &testGame{
+				live:   true,
+				period: "2nd",
+			},
+		},
+		{
+			name: "final",
+			game: &testGame{
+				complete: true,
+				period:   "Final",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		for _, size := range boardtest.Geometries {
+			name := boardtest.Name("nhl-"+test.name, size)
+			t.Run(name, func(t *testing.T) {
+				game := *test.game
+				game.home = &testTeam{abbrev: "BOS", score: 3}
+				game.away = &testTeam{abbrev: "NYR", score: 2}
+				game.start = start
+
+				cfg := &Config{
+					Enabled:    atomic.NewBool(true),
+					ShowRecord: atomic.NewBool(true),
+					TodayFunc: func() []time.Time {
+						return []time.Time{start}
+					},
+				}
+				cfg.SetDefaults()
+
+				api := &goldenAPI{
+					game:    &game,
+					logoDir: t.TempDir(),
+				}
+				b, err := New(context.Background(), api, image.Rect(0, 0, size.X, size.Y), zap.NewNop(), cfg)
+				require.NoError(t, err)
+
+				boardtest.Golden(t, name, boardtest.Render(t, b, size))
+			})
+		}
+	}
+}
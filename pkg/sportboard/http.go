@@ -15,20 +15,23 @@ func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 	return []*board.HTTPHandler{
 		{
 			Path: fmt.Sprintf("/%s/hidefavoritescore", s.api.HTTPPathPrefix()),
-			Handler: func(http.ResponseWriter, *http.Request) {
+			Handler: func(w http.ResponseWriter, req *http.Request) {
 				s.log.Info("hiding favorite team scores")
 				s.config.HideFavoriteScore.Store(true)
+				board.RecordToggles(req.Context(), s.config.HideFavoriteScore)
 			},
 		},
 		{
 			Path: fmt.Sprintf("/%s/showfavoritescore", s.api.HTTPPathPrefix()),
-			Handler: func(http.ResponseWriter, *http.Request) {
+			Handler: func(w http.ResponseWriter, req *http.Request) {
 				s.log.Info("showing favorite team scores")
 				s.config.HideFavoriteScore.Store(false)
+				board.RecordToggles(req.Context(), s.config.HideFavoriteScore)
 			},
 		},
 		{
-			Path: fmt.Sprintf("/%s/favoritescorestatus", s.api.HTTPPathPrefix()),
+			Path:     fmt.Sprintf("/%s/favoritescorestatus", s.api.HTTPPathPrefix()),
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				w.Header().Set("Content-Type", "text/plain")
 				if s.config.HideFavoriteScore.Load() {
@@ -43,6 +46,7 @@ func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Handler: func(wrter http.ResponseWriter, req *http.Request) {
 				s.log.Info("setting favorite teams to sticky")
 				s.config.FavoriteSticky.Store(true)
+				board.RecordToggles(req.Context(), s.config.FavoriteSticky)
 			},
 		},
 		{
@@ -50,10 +54,12 @@ func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Handler: func(wrter http.ResponseWriter, req *http.Request) {
 				s.log.Info("setting favorite teams to not stick")
 				s.config.FavoriteSticky.Store(false)
+				board.RecordToggles(req.Context(), s.config.FavoriteSticky)
 			},
 		},
 		{
-			Path: fmt.Sprintf("/%s/favoritestickystatus", s.api.HTTPPathPrefix()),
+			Path:     fmt.Sprintf("/%s/favoritestickystatus", s.api.HTTPPathPrefix()),
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				w.Header().Set("Content-Type", "text/plain")
 				if s.config.FavoriteSticky.Load() {
@@ -68,6 +74,7 @@ func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Handler: func(wrter http.ResponseWriter, req *http.Request) {
 				s.log.Info("disabling board", zap.String("board", s.Name()))
 				s.Disable()
+				board.RecordToggles(req.Context(), s.config.Enabled)
 			},
 		},
 		{
@@ -75,10 +82,12 @@ func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Handler: func(wrter http.ResponseWriter, req *http.Request) {
 				s.log.Info("enabling board", zap.String("board", s.Name()))
 				s.Enable()
+				board.RecordToggles(req.Context(), s.config.Enabled)
 			},
 		},
 		{
-			Path: fmt.Sprintf("/%s/status", s.api.HTTPPathPrefix()),
+			Path:     fmt.Sprintf("/%s/status", s.api.HTTPPathPrefix()),
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				s.log.Debug("get board status", zap.String("board", s.Name()))
 				w.Header().Set("Content-Type", "text/plain")
@@ -103,6 +112,7 @@ func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				s.log.Info("enabling scroll mode", zap.String("board", s.api.League()))
 				s.callCancelBoard()
 				s.config.ScrollMode.Store(true)
+				board.RecordToggles(req.Context(), s.config.ScrollMode)
 				s.api.CacheClear(context.Background())
 			},
 		},
@@ -112,11 +122,13 @@ func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				s.log.Info("disabling scroll mode", zap.String("board", s.api.League()))
 				s.callCancelBoard()
 				s.config.ScrollMode.Store(false)
+				board.RecordToggles(req.Context(), s.config.ScrollMode)
 				s.api.CacheClear(context.Background())
 			},
 		},
 		{
-			Path: fmt.Sprintf("/%s/scrollstatus", s.api.HTTPPathPrefix()),
+			Path:     fmt.Sprintf("/%s/scrollstatus", s.api.HTTPPathPrefix()),
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				s.log.Debug("get board scroll status", zap.String("board", s.Name()))
 				w.Header().Set("Content-Type", "text/plain")
@@ -133,6 +145,7 @@ func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				s.log.Info("enabling tight scroll mode", zap.String("board", s.api.League()))
 				s.callCancelBoard()
 				s.config.TightScroll.Store(true)
+				board.RecordToggles(req.Context(), s.config.TightScroll)
 				s.api.CacheClear(context.Background())
 			},
 		},
@@ -142,11 +155,13 @@ func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				s.log.Info("disabling scroll mode", zap.String("board", s.api.League()))
 				s.callCancelBoard()
 				s.config.TightScroll.Store(false)
+				board.RecordToggles(req.Context(), s.config.TightScroll)
 				s.api.CacheClear(context.Background())
 			},
 		},
 		{
-			Path: fmt.Sprintf("/%s/tightscrollstatus", s.api.HTTPPathPrefix()),
+			Path:     fmt.Sprintf("/%s/tightscrollstatus", s.api.HTTPPathPrefix()),
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				s.log.Debug("get board tight scroll status", zap.String("board", s.Name()))
 				w.Header().Set("Content-Type", "text/plain")
@@ -163,6 +178,7 @@ func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				s.log.Info("enabling team record/rank mode", zap.String("board", s.api.League()))
 				s.callCancelBoard()
 				s.config.ShowRecord.Store(true)
+				board.RecordToggles(req.Context(), s.config.ShowRecord)
 				s.api.CacheClear(context.Background())
 			},
 		},
@@ -172,11 +188,13 @@ func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				s.log.Info("disabling team record/rank mode", zap.String("board", s.api.League()))
 				s.callCancelBoard()
 				s.config.ShowRecord.Store(false)
+				board.RecordToggles(req.Context(), s.config.ShowRecord)
 				s.api.CacheClear(context.Background())
 			},
 		},
 		{
-			Path: fmt.Sprintf("/%s/recordrankstatus", s.api.HTTPPathPrefix()),
+			Path:     fmt.Sprintf("/%s/recordrankstatus", s.api.HTTPPathPrefix()),
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				s.log.Debug("get team record/rank status", zap.String("board", s.Name()))
 				w.Header().Set("Content-Type", "text/plain")
@@ -193,6 +211,7 @@ func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				s.log.Info("enabling odds mode", zap.String("board", s.api.League()))
 				s.callCancelBoard()
 				s.config.GamblingSpread.Store(true)
+				board.RecordToggles(req.Context(), s.config.GamblingSpread)
 				s.api.CacheClear(context.Background())
 			},
 		},
@@ -202,11 +221,13 @@ func (s *SportBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				s.log.Info("disabling odds mode", zap.String("board", s.api.League()))
 				s.callCancelBoard()
 				s.config.GamblingSpread.Store(false)
+				board.RecordToggles(req.Context(), s.config.GamblingSpread)
 				s.api.CacheClear(context.Background())
 			},
 		},
 		{
-			Path: fmt.Sprintf("/%s/oddsstatus", s.api.HTTPPathPrefix()),
+			Path:     fmt.Sprintf("/%s/oddsstatus", s.api.HTTPPathPrefix()),
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				s.log.Debug("get odds status", zap.String("board", s.Name()))
 				w.Header().Set("Content-Type", "text/plain")
@@ -0,0 +1,116 @@
+package sportboard
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/simclock"
+)
+
+// HasPriority returns true when a favorite team is playing in a live game and
+// the board is set to be favoriteSticky. The matrix polls this to preempt whatever
+// board is currently showing.
+func (s *SportBoard) HasPriority(ctx context.Context) bool {
+	if !s.config.Enabled.Load() || !s.config.FavoriteSticky.Load() || len(s.favoriteTeams()) < 1 {
+		s.setPriorityGames(nil)
+		return false
+	}
+
+	ids, err := s.livePriorityGames(ctx)
+	if err != nil {
+		s.log.Error("failed to check for priority games",
+			zap.String("league", s.api.League()),
+			zap.Error(err),
+		)
+		return false
+	}
+
+	s.setPriorityGames(ids)
+
+	return len(ids) > 0
+}
+
+// livePriorityGames returns the IDs of today's live games that include a favorite team
+func (s *SportBoard) livePriorityGames(ctx context.Context) ([]int, error) {
+	allGames, err := s.api.GetScheduledGames(ctx, s.config.TodayFunc())
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int
+
+GAMES:
+	for _, game := range allGames {
+		isFavorite, err := s.isFavoriteGame(game)
+		if err != nil || !isFavorite {
+			continue GAMES
+		}
+
+		startTime, err := game.GetStartTime(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if simclock.Until(startTime) > 0 {
+			continue GAMES
+		}
+
+		if cached, err := s.getCachedGame(game.GetID()); err == nil && cached != nil {
+			if over, err := cached.IsComplete(); err == nil && over {
+				continue GAMES
+			}
+		}
+
+		liveGame, err := game.GetUpdate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.setCachedGame(game.GetID(), liveGame)
+
+		isLive, err := liveGame.IsLive()
+		if err != nil {
+			return nil, err
+		}
+		if isLive {
+			ids = append(ids, game.GetID())
+		}
+	}
+
+	return ids, nil
+}
+
+func (s *SportBoard) setPriorityGames(ids []int) {
+	s.priorityLock.Lock()
+	defer s.priorityLock.Unlock()
+
+	for k := range s.priorityGames {
+		delete(s.priorityGames, k)
+	}
+	for _, id := range ids {
+		s.priorityGames[id] = struct{}{}
+	}
+}
+
+// filterPriorityGames limits the given games to only those that have priority.
+// If no games have priority, the games are returned unfiltered.
+func (s *SportBoard) filterPriorityGames(games []Game) []Game {
+	s.priorityLock.RLock()
+	defer s.priorityLock.RUnlock()
+
+	if len(s.priorityGames) < 1 {
+		return games
+	}
+
+	var priority []Game
+	for _, game := range games {
+		if _, ok := s.priorityGames[game.GetID()]; ok {
+			priority = append(priority, game)
+		}
+	}
+
+	if len(priority) < 1 {
+		return games
+	}
+
+	return priority
+}
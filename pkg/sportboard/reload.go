@@ -0,0 +1,153 @@
+package sportboard
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/simclock"
+)
+
+// UpdateConfig applies the config fields that can be changed while the board is running.
+// It returns the json names of the fields it handles
+func (s *SportBoard) UpdateConfig(config *Config) ([]string, error) {
+	s.Lock()
+	s.config.WatchTeams = config.WatchTeams
+	if len(s.config.WatchTeams) == 0 {
+		s.config.WatchTeams = []string{"ALL"}
+	}
+	s.config.FavoriteTeams = config.FavoriteTeams
+	// Watch teams are determined again on the next render
+	s.watchTeams = nil
+
+	s.config.BoardDelay = config.BoardDelay
+	s.config.boardDelay = config.boardDelay
+	if s.config.boardDelay < 10*time.Second {
+		s.log.Warn("cannot set sportboard delay below 10 sec")
+		s.config.boardDelay = 10 * time.Second
+	}
+	s.config.ScrollDelay = config.ScrollDelay
+	s.config.scrollDelay = config.scrollDelay
+
+	s.config.OnTimes = config.OnTimes
+	s.config.OffTimes = config.OffTimes
+	s.Unlock()
+
+	fields := []string{
+		"watchTeams",
+		"favoriteTeams",
+		"boardDelay",
+		"scrollDelay",
+		"onTimes",
+		"offTimes",
+	}
+
+	return fields, s.scheduleOnOff()
+}
+
+// boardDelay returns the config's board delay, which can change on a config reload
+func (s *SportBoard) boardDelay() time.Duration {
+	s.Lock()
+	defer s.Unlock()
+	return s.config.boardDelay
+}
+
+// scrollDelay returns the config's scroll delay, which can change on a config reload
+func (s *SportBoard) scrollDelay() time.Duration {
+	s.Lock()
+	defer s.Unlock()
+	return s.config.scrollDelay
+}
+
+// favoriteTeams returns the config's favorite teams, which can change on a config reload
+func (s *SportBoard) favoriteTeams() []string {
+	s.Lock()
+	defer s.Unlock()
+	return s.config.FavoriteTeams
+}
+
+// getWatchTeams returns the IDs of the watched teams, determining them from the config if
+// they haven't been since the last config reload
+func (s *SportBoard) getWatchTeams() []string {
+	s.Lock()
+	watchTeams := s.watchTeams
+	configTeams := s.config.WatchTeams
+	s.Unlock()
+
+	if len(watchTeams) > 0 {
+		return watchTeams
+	}
+
+	s.log.Debug("fetching watch teams",
+		zap.String("league", s.api.League()),
+	)
+	watchTeams = s.api.GetWatchTeams(configTeams, s.season())
+	s.log.Debug("watch teams",
+		zap.String("league", s.api.League()),
+		zap.Strings("teams", watchTeams),
+	)
+
+	s.Lock()
+	defer s.Unlock()
+	s.watchTeams = watchTeams
+
+	return watchTeams
+}
+
+// scheduleOnOff sets the cron schedules for turning the board on and off, replacing
+// any previous schedules
+func (s *SportBoard) scheduleOnOff() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.onOffCron != nil {
+		s.onOffCron.Stop()
+		s.onOffCron = nil
+	}
+
+	if len(s.config.OnTimes) < 1 && len(s.config.OffTimes) < 1 {
+		return nil
+	}
+
+	c := simclock.NewCron()
+
+	for _, on := range s.config.OnTimes {
+		s.log.Info("sportboard will be schedule to turn on",
+			zap.String("league", s.api.League()),
+			zap.String("turn on", on),
+		)
+		_, err := c.AddFunc(on, func() {
+			s.log.Info("sportboard turning on",
+				zap.String("league", s.api.League()),
+			)
+			s.diagnostics.SetOffHours(false)
+			s.Enable()
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add cron for sportboard: %w", err)
+		}
+	}
+
+	for _, off := range s.config.OffTimes {
+		s.log.Info("sportboard will be schedule to turn off",
+			zap.String("league", s.api.League()),
+			zap.String("turn on", off),
+		)
+		_, err := c.AddFunc(off, func() {
+			s.log.Info("sportboard turning off",
+				zap.String("league", s.api.League()),
+			)
+			s.diagnostics.SetOffHours(true)
+			s.Disable()
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add cron for sportboard: %w", err)
+		}
+	}
+
+	c.Start()
+	s.onOffCron = c
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package sportboard
+
+import (
+	"context"
+	"image"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+func TestUpdateConfig(t *testing.T) {
+	cfg := &Config{
+		Enabled:       atomic.NewBool(true),
+		FavoriteTeams: []string{"BOS"},
+	}
+	cfg.SetDefaults()
+
+	api := &goldenAPI{
+		game: &testGame{
+			home: &testTeam{abbrev: "BOS"},
+			away: &testTeam{abbrev: "NYR"},
+		},
+		logoDir: t.TempDir(),
+	}
+	b, err := New(context.Background(), api, image.Rect(0, 0, 64, 32), zap.NewNop(), cfg)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_ = b.isFavorite("BOS")
+			_ = b.getWatchTeams()
+			_ = b.boardDelay()
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		update := &Config{
+			FavoriteTeams: []string{"NYR"},
+			BoardDelay:    "20s",
+		}
+		update.SetDefaults()
+		_, err := b.UpdateConfig(update)
+		require.NoError(t, err)
+	}
+	wg.Wait()
+
+	require.True(t, b.isFavorite("NYR"))
+	require.False(t, b.isFavorite("BOS"))
+	require.Equal(t, 20*time.Second, b.boardDelay())
+	require.Equal(t, []string{"ALL"}, b.config.WatchTeams)
+}
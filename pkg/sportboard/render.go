@@ -13,6 +13,7 @@ import (
 
 	"github.com/robbydyer/sports/pkg/board"
 	"github.com/robbydyer/sports/pkg/rgbrender"
+	"github.com/robbydyer/sports/pkg/simclock"
 )
 
 const (
@@ -287,7 +288,7 @@ func (s *SportBoard) renderLiveGame(ctx context.Context, canvas board.Canvas, li
 		select {
 		case <-ctx.Done():
 			return context.Canceled
-		case <-time.After(s.config.boardDelay - 3*time.Second):
+		case <-time.After(s.boardDelay() - 3*time.Second):
 		}
 
 		liveGame, err = liveGame.GetUpdate(ctx)
@@ -363,7 +364,7 @@ func (s *SportBoard) renderUpcomingGame(ctx context.Context, canvas board.Canvas
 						return nil, nil, err
 					}
 					gameTimeStr = gameTime.Local().Format("3:04PM")
-					if gameTime.Local().Format("01/02/2006") != time.Now().Local().Format("01/02/2006") {
+					if gameTime.Local().Format("01/02/2006") != simclock.Now().Local().Format("01/02/2006") {
 						dateStr = gameTime.Local().Format("01/02")
 					}
 					s.log.Debug("game time",
@@ -1011,7 +1012,7 @@ func (s *SportBoard) renderNoScheduled(ctx context.Context, canvas board.Canvas)
 	select {
 	case <-ctx.Done():
 		return context.Canceled
-	case <-time.After(s.config.boardDelay / 2):
+	case <-time.After(s.boardDelay() / 2):
 		return nil
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	pb "github.com/robbydyer/sports/internal/proto/sportboard"
+	"github.com/robbydyer/sports/pkg/board"
 )
 
 // Server ...
@@ -30,41 +31,51 @@ func (s *Server) SetStatus(ctx context.Context, req *pb.SetStatusReq) (*emptypb.
 	}
 
 	if s.board.config.HideFavoriteScore.CAS(!req.Status.FavoriteHidden, req.Status.FavoriteHidden) {
+		board.RecordToggles(ctx, s.board.config.HideFavoriteScore)
 		cancelBoard = true
 	}
 	if req.Status.Enabled {
 		if s.board.Enable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
 			cancelBoard = true
 		}
 	} else {
 		if s.board.Disable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
 			cancelBoard = true
 		}
 	}
 	if s.board.config.FavoriteSticky.CAS(!req.Status.FavoriteSticky, req.Status.FavoriteSticky) {
+		board.RecordToggles(ctx, s.board.config.FavoriteSticky)
 		cancelBoard = true
 	}
 	if s.board.config.GamblingSpread.CAS(!req.Status.OddsEnabled, req.Status.OddsEnabled) {
+		board.RecordToggles(ctx, s.board.config.GamblingSpread)
 		cancelBoard = true
 		clearDrawCache = true
 	}
 	if s.board.config.ScrollMode.CAS(!req.Status.ScrollEnabled, req.Status.ScrollEnabled) {
+		board.RecordToggles(ctx, s.board.config.ScrollMode)
 		cancelBoard = true
 		clearDrawCache = true
 	}
 	if s.board.config.TightScroll.CAS(!req.Status.TightScrollEnabled, req.Status.TightScrollEnabled) {
+		board.RecordToggles(ctx, s.board.config.TightScroll)
 		cancelBoard = true
 		clearDrawCache = true
 	}
 	if s.board.config.ShowRecord.CAS(!req.Status.RecordRankEnabled, req.Status.RecordRankEnabled) {
+		board.RecordToggles(ctx, s.board.config.ShowRecord)
 		cancelBoard = true
 		clearDrawCache = true
 	}
 	if s.board.config.UseGradient.CAS(!req.Status.UseGradient, req.Status.UseGradient) {
+		board.RecordToggles(ctx, s.board.config.UseGradient)
 		cancelBoard = true
 		clearDrawCache = true
 	}
 	if s.board.config.LiveOnly.CAS(!req.Status.LiveOnly, req.Status.LiveOnly) {
+		board.RecordToggles(ctx, s.board.config.LiveOnly)
 		cancelBoard = true
 	}
 
@@ -21,6 +21,7 @@ import (
 	"github.com/robbydyer/sports/pkg/logo"
 	"github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
 	"github.com/robbydyer/sports/pkg/rgbrender"
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/statboard"
 	"github.com/robbydyer/sports/pkg/textboard"
 	"github.com/robbydyer/sports/pkg/twirphelpers"
@@ -45,6 +46,7 @@ type SportBoard struct {
 	logoDrawCache       map[string]image.Image
 	scoreWriters        map[string]*rgbrender.TextWriter
 	timeWriters         map[string]*rgbrender.TextWriter
+	writerLock          sync.RWMutex
 	teamInfoWidths      map[string]map[string]int
 	watchTeams          []string
 	teamInfoLock        sync.RWMutex
@@ -57,6 +59,10 @@ type SportBoard struct {
 	stateChangeNotifier board.StateChangeNotifier
 	renderCtx           context.Context
 	renderCancel        context.CancelFunc
+	priorityGames       map[int]struct{}
+	priorityLock        sync.RWMutex
+	onOffCron           *cron.Cron
+	diagnostics         board.DiagnosticsTracker
 	sync.Mutex
 }
 
@@ -67,11 +73,11 @@ type Todayer func() []time.Time
 
 // Config ...
 type Config struct {
-	TodayFunc            Todayer
+	TodayFunc            Todayer `json:"-"`
 	boardDelay           time.Duration
 	scrollDelay          time.Duration
-	TimeColor            color.Color
-	ScoreColor           color.Color
+	TimeColor            color.Color       `json:"-"`
+	ScoreColor           color.Color       `json:"-"`
 	Enabled              *atomic.Bool      `json:"enabled"`
 	BoardDelay           string            `json:"boardDelay"`
 	FavoriteSticky       *atomic.Bool      `json:"favoriteSticky"`
@@ -234,6 +240,7 @@ func New(ctx context.Context, api API, bounds image.Rectangle, logger *zap.Logge
 		scoreWriters:    make(map[string]*rgbrender.TextWriter),
 		cancelBoard:     make(chan struct{}),
 		teamInfoWidths:  make(map[string]map[string]int),
+		priorityGames:   make(map[int]struct{}),
 	}
 
 	if s.config.boardDelay < 10*time.Second {
@@ -261,7 +268,7 @@ func New(ctx context.Context, api API, bounds image.Rectangle, logger *zap.Logge
 		config.WatchTeams = []string{"ALL"}
 	}
 
-	c := cron.New()
+	c := simclock.NewCron()
 
 	if _, err := c.AddFunc("0 4 * * *", s.cacheClear); err != nil {
 		return nil, fmt.Errorf("failed to set cron for cacheClear: %w", err)
@@ -281,36 +288,8 @@ func New(ctx context.Context, api API, bounds image.Rectangle, logger *zap.Logge
 		),
 	)
 
-	for _, on := range config.OnTimes {
-		s.log.Info("sportboard will be schedule to turn on",
-			zap.String("league", s.api.League()),
-			zap.String("turn on", on),
-		)
-		_, err := c.AddFunc(on, func() {
-			s.log.Info("sportboard turning on",
-				zap.String("league", s.api.League()),
-			)
-			s.Enable()
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to add cron for sportboard: %w", err)
-		}
-	}
-
-	for _, off := range config.OffTimes {
-		s.log.Info("sportboard will be schedule to turn off",
-			zap.String("league", s.api.League()),
-			zap.String("turn on", off),
-		)
-		_, err := c.AddFunc(off, func() {
-			s.log.Info("sportboard turning off",
-				zap.String("league", s.api.League()),
-			)
-			s.Disable()
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to add cron for sportboard: %w", err)
-		}
+	if err := s.scheduleOnOff(); err != nil {
+		return nil, err
 	}
 
 	c.Start()
@@ -359,6 +338,16 @@ func (s *SportBoard) Enabled() bool {
 	return s.config.Enabled.Load()
 }
 
+// EnabledToggle ...
+func (s *SportBoard) EnabledToggle() *atomic.Bool {
+	return s.config.Enabled
+}
+
+// Diagnostics ...
+func (s *SportBoard) Diagnostics() *board.Diagnostics {
+	return s.diagnostics.Diagnostics()
+}
+
 // Enable ...
 func (s *SportBoard) Enable() bool {
 	if s.config.Enabled.CAS(false, true) {
@@ -398,12 +387,18 @@ func (s *SportBoard) ScrollMode() bool {
 }
 
 // SetLiveOnly sets this board to show only live games or not
-func (s *SportBoard) SetLiveOnly(live bool) {
+func (s *SportBoard) SetLiveOnly(ctx context.Context, live bool) {
 	if s.config.LiveOnly.CAS(!live, live) {
+		board.RecordToggles(ctx, s.config.LiveOnly)
 		s.callCancelBoard()
 	}
 }
 
+// LiveOnly returns whether this board shows only live games
+func (s *SportBoard) LiveOnly() bool {
+	return s.config.LiveOnly.Load()
+}
+
 // GridSize returns the column width and row height for a grid layout. 0 is returned for
 // both if the canvas is too small for a grid.
 func (s *SportBoard) GridSize(bounds image.Rectangle) (int, int) {
@@ -496,6 +491,7 @@ func (s *SportBoard) render(ctx context.Context, canvas board.Canvas) (board.Can
 			zap.String("league", s.api.League()),
 			zap.Error(err),
 		)
+		s.diagnostics.Skipped(board.SkipAPIFailure)
 		return nil, err
 	}
 
@@ -503,24 +499,18 @@ func (s *SportBoard) render(ctx context.Context, canvas board.Canvas) (board.Can
 		s.log.Debug("no games scheduled",
 			zap.String("league", s.api.League()),
 		)
+		s.diagnostics.Skipped(board.SkipNoGames)
 		return nil, fmt.Errorf("no games scheduled for %s", s.api.League())
 	}
 
 	if _, err := s.api.GetTeams(ctx); err != nil {
+		s.diagnostics.Skipped(board.SkipAPIFailure)
 		return nil, err
 	}
+	s.diagnostics.DataLoaded()
 
 	// Determine which games are watched so that the game counter is accurate
-	if len(s.watchTeams) < 1 {
-		s.log.Debug("fetching watch teams",
-			zap.String("league", s.api.League()),
-		)
-		s.watchTeams = s.api.GetWatchTeams(s.config.WatchTeams, s.season())
-		s.log.Debug("watch teams",
-			zap.String("league", s.api.League()),
-			zap.Strings("teams", s.watchTeams),
-		)
-	}
+	watchTeams := s.getWatchTeams()
 
 	var games []Game
 OUTER:
@@ -535,7 +525,7 @@ OUTER:
 			s.log.Error("failed to get away team", zap.Error(err))
 			continue OUTER
 		}
-		for _, watchTeamID := range s.watchTeams {
+		for _, watchTeamID := range watchTeams {
 			if home.GetID() == watchTeamID || away.GetID() == watchTeamID {
 				isLive, err := game.IsLive()
 				if err != nil {
@@ -557,6 +547,9 @@ OUTER:
 		}
 	}
 
+	// A favorite team's live game has priority over the rest of the schedule
+	games = s.filterPriorityGames(games)
+
 	var todays []string
 	for _, t := range s.config.TodayFunc() {
 		todays = append(todays, t.String())
@@ -616,7 +609,7 @@ OUTER:
 		s.log.Error("error while loading live game data for first game", zap.Error(err))
 	}
 
-	preloaderTimeout := s.config.boardDelay + (10 * time.Second)
+	preloaderTimeout := s.boardDelay() + (10 * time.Second)
 
 	defer func() { _ = canvas.Clear() }()
 
@@ -634,7 +627,7 @@ OUTER:
 		}
 
 		tightCanvas.SetScrollDirection(rgbmatrix.RightToLeft)
-		tightCanvas.SetScrollSpeed(s.config.scrollDelay)
+		tightCanvas.SetScrollSpeed(s.scrollDelay())
 	} else if canvas.Scrollable() && s.config.ScrollMode.Load() {
 		scroll, ok := canvas.(*rgbmatrix.ScrollCanvas)
 		if ok {
@@ -642,9 +635,9 @@ OUTER:
 			defer func() { scroll.SetScrollSpeed(orig) }()
 			s.log.Debug("setting scroll delay",
 				zap.String("league", s.api.League()),
-				zap.String("delay", s.config.scrollDelay.String()),
+				zap.String("delay", s.scrollDelay().String()),
 			)
-			scroll.SetScrollSpeed(s.config.scrollDelay)
+			scroll.SetScrollSpeed(s.scrollDelay())
 		}
 	}
 
@@ -730,7 +723,7 @@ GAMES:
 			select {
 			case <-s.renderCtx.Done():
 				return nil, context.Canceled
-			case <-time.After(s.config.boardDelay):
+			case <-time.After(s.boardDelay()):
 			}
 		}
 	}
@@ -765,7 +758,7 @@ func (s *SportBoard) renderGrid(ctx context.Context, canvas board.Canvas, games
 
 	numCells := len(grid.Cells())
 	numGrids := int(math.Ceil(float64(len(games)) / float64(numCells)))
-	totalDelay := int(s.config.boardDelay.Seconds()) * len(games)
+	totalDelay := int(s.boardDelay().Seconds()) * len(games)
 
 	if numGrids == 0 {
 		numGrids = 1
@@ -931,11 +924,6 @@ func (s *SportBoard) renderGame(ctx context.Context, canvas board.Canvas, liveGa
 	return nil
 }
 
-// HasPriority ...
-func (s *SportBoard) HasPriority() bool {
-	return false
-}
-
 func (s *SportBoard) setCachedGame(key int, game Game) {
 	s.Lock()
 	defer s.Unlock()
@@ -985,10 +973,10 @@ func (s *SportBoard) preloadLiveGame(ctx context.Context, game Game, preload cha
 			return fmt.Errorf("failed to determine start time of game: %w", err)
 		}
 
-		if time.Until(startTime).Minutes() > 30 {
+		if simclock.Until(startTime).Minutes() > 30 {
 			s.log.Warn("game has not started, not fetching live data yet",
 				zap.Int("game ID", cached.GetID()),
-				zap.Float64("min until start", time.Until(startTime).Minutes()),
+				zap.Float64("min until start", simclock.Until(startTime).Minutes()),
 			)
 
 			return nil
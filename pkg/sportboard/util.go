@@ -65,7 +65,9 @@ func (s *SportBoard) getTimeWriter(canvasBounds image.Rectangle) (*rgbrender.Tex
 	)
 
 	k := fmt.Sprintf("%dx%d", bounds.Dx(), bounds.Dy())
+	s.writerLock.RLock()
 	w, ok := s.timeWriters[k]
+	s.writerLock.RUnlock()
 	if ok {
 		s.log.Debug("using cached time writer")
 		return w, nil
@@ -100,8 +102,8 @@ func (s *SportBoard) getTimeWriter(canvasBounds image.Rectangle) (*rgbrender.Tex
 		zap.Int("Y correction", timeWriter.YStartCorrection),
 	)
 
-	s.Lock()
-	defer s.Unlock()
+	s.writerLock.Lock()
+	defer s.writerLock.Unlock()
 	s.timeWriters[k] = timeWriter
 
 	return timeWriter, nil
@@ -111,7 +113,9 @@ func (s *SportBoard) getScoreWriter(canvasBounds image.Rectangle) (*rgbrender.Te
 	bounds := rgbrender.ZeroedBounds(canvasBounds)
 
 	k := fmt.Sprintf("%dx%d", bounds.Dx(), bounds.Dy())
+	s.writerLock.RLock()
 	w, ok := s.scoreWriters[k]
+	s.writerLock.RUnlock()
 	if ok {
 		s.log.Debug("using cached score writer")
 		return w, nil
@@ -150,8 +154,8 @@ func (s *SportBoard) getScoreWriter(canvasBounds image.Rectangle) (*rgbrender.Te
 		zap.Int("Y correction", scoreWriter.YStartCorrection),
 	)
 
-	s.Lock()
-	defer s.Unlock()
+	s.writerLock.Lock()
+	defer s.writerLock.Unlock()
 	s.scoreWriters[k] = scoreWriter
 	return scoreWriter, nil
 }
@@ -170,7 +174,7 @@ func (s *SportBoard) isFavoriteGame(game Game) (bool, error) {
 }
 
 func (s *SportBoard) isFavorite(abbrev string) bool {
-	for _, a := range s.config.FavoriteTeams {
+	for _, a := range s.favoriteTeams() {
 		if abbrev == a {
 			return true
 		}
@@ -0,0 +1,247 @@
+package sportsmatrix
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/simclock"
+)
+
+const (
+	defaultNightBrightness = 10
+	// The sun brightness curve fades between night and day while the sun is between these elevations
+	sunFadeLow  = -6.0
+	sunFadeHigh = 6.0
+	// How far back to look for the scheduled level in effect at startup
+	brightnessLookback = 7 * 24 * time.Hour
+)
+
+// Dimmer sets the brightness of the matrix
+type Dimmer interface {
+	SetBrightness(brightness int)
+}
+
+// BrightnessSchedule changes the brightness of the matrix throughout the day, either at
+// cron scheduled times, or following the sun
+type BrightnessSchedule struct {
+	Levels []*BrightnessLevel `json:"levels"`
+	Sun    *SunBrightness     `json:"sun"`
+}
+
+// BrightnessLevel sets the brightness at a cron scheduled time
+type BrightnessLevel struct {
+	Time       string `json:"time"`
+	Brightness int    `json:"brightness"`
+}
+
+// SunBrightness fades the brightness between night and day levels around sunrise and sunset
+// for the given location. The sun's position is calculated locally, no network is needed
+type SunBrightness struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Day       int     `json:"day"`
+	Night     int     `json:"night"`
+}
+
+// SetDefaults ...
+func (b *SunBrightness) SetDefaults(day int) {
+	if b.Day == 0 {
+		b.Day = day
+	}
+	if b.Night == 0 {
+		b.Night = defaultNightBrightness
+	}
+}
+
+func (c *Config) validateBrightness() error {
+	if err := validBrightness(c.HardwareConfig.Brightness); err != nil {
+		return err
+	}
+
+	sched := c.BrightnessSchedule
+	if sched == nil {
+		return nil
+	}
+	if len(sched.Levels) > 0 && sched.Sun != nil {
+		return fmt.Errorf("brightness schedule can't have both levels and sun")
+	}
+	for _, l := range sched.Levels {
+		if err := validBrightness(l.Brightness); err != nil {
+			return err
+		}
+		if _, err := cron.ParseStandard(l.Time); err != nil {
+			return fmt.Errorf("invalid brightness schedule time %q: %w", l.Time, err)
+		}
+	}
+	if sched.Sun != nil {
+		if sched.Sun.Latitude < -90 || sched.Sun.Latitude > 90 || sched.Sun.Longitude < -180 || sched.Sun.Longitude > 180 {
+			return fmt.Errorf("invalid brightness schedule location %f,%f", sched.Sun.Latitude, sched.Sun.Longitude)
+		}
+		if err := validBrightness(sched.Sun.Day); err != nil {
+			return err
+		}
+		if err := validBrightness(sched.Sun.Night); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validBrightness(brightness int) error {
+	if brightness < 1 || brightness > 100 {
+		return fmt.Errorf("invalid brightness %d, must be between 1 and 100", brightness)
+	}
+	return nil
+}
+
+// SetDimmer sets the Dimmer used for changing the matrix brightness. This must be called before Serve
+func (s *SportsMatrix) SetDimmer(d Dimmer) {
+	s.dimmer = d
+}
+
+// SetBrightness sets the brightness of the matrix, in percent. Scheduled brightness changes
+// take over again at the next scheduled change
+func (s *SportsMatrix) SetBrightness(brightness int) error {
+	if s.dimmer == nil {
+		return fmt.Errorf("brightness control is not supported")
+	}
+	if err := validBrightness(brightness); err != nil {
+		return err
+	}
+
+	s.log.Info("setting brightness", zap.Int("brightness", brightness))
+	s.dimmer.SetBrightness(brightness)
+	s.brightness.Store(int32(brightness))
+
+	return nil
+}
+
+// Brightness returns the current brightness of the matrix, in percent
+func (s *SportsMatrix) Brightness() int {
+	return int(s.brightness.Load())
+}
+
+// scheduleBrightness adds the cron scheduled brightness levels
+func (s *SportsMatrix) scheduleBrightness(c *cron.Cron) error {
+	if s.cfg.BrightnessSchedule == nil {
+		return nil
+	}
+
+	for _, l := range s.cfg.BrightnessSchedule.Levels {
+		level := l.Brightness
+		s.log.Info("Brightness will be scheduled to change",
+			zap.String("time", l.Time),
+			zap.Int("brightness", level),
+		)
+		_, err := c.AddFunc(l.Time, func() {
+			if err := s.SetBrightness(level); err != nil {
+				s.log.Error("failed to set scheduled brightness", zap.Error(err))
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add cron for brightness schedule: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// serveBrightness applies the scheduled brightness at startup, then follows the sun if configured
+func (s *SportsMatrix) serveBrightness(ctx context.Context) {
+	sched := s.cfg.BrightnessSchedule
+	if s.dimmer == nil || sched == nil {
+		return
+	}
+
+	if sched.Sun == nil {
+		if level, ok := scheduledBrightness(sched.Levels, simclock.Now()); ok {
+			if err := s.SetBrightness(level); err != nil {
+				s.log.Error("failed to set scheduled brightness", zap.Error(err))
+			}
+		}
+		return
+	}
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	// Only changes in the curve are applied, so that brightness set through the API
+	// sticks until the next sunrise or sunset
+	last := 0
+	for {
+		level := sched.Sun.brightness(simclock.Now())
+		if level != last {
+			if err := s.SetBrightness(level); err != nil {
+				s.log.Error("failed to set sun brightness", zap.Error(err))
+			}
+			last = level
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// scheduledBrightness returns the level of the most recent scheduled change before now
+func scheduledBrightness(levels []*BrightnessLevel, now time.Time) (int, bool) {
+	var latest time.Time
+	level := 0
+	for _, l := range levels {
+		sched, err := cron.ParseStandard(l.Time)
+		if err != nil {
+			continue
+		}
+		var prev time.Time
+		for t := sched.Next(now.Add(-brightnessLookback)); !t.IsZero() && !t.After(now); t = sched.Next(t) {
+			prev = t
+		}
+		if !prev.IsZero() && prev.After(latest) {
+			latest = prev
+			level = l.Brightness
+		}
+	}
+
+	return level, level > 0
+}
+
+// brightness returns the level for the sun's current elevation, fading smoothly through twilight
+func (b *SunBrightness) brightness(t time.Time) int {
+	elevation := sunElevation(t, b.Latitude, b.Longitude)
+
+	f := (elevation - sunFadeLow) / (sunFadeHigh - sunFadeLow)
+	f = math.Max(0, math.Min(1, f))
+	f = f * f * (3 - 2*f)
+
+	return int(math.Round(float64(b.Night) + f*float64(b.Day-b.Night)))
+}
+
+// sunElevation returns the sun's elevation above the horizon in degrees at the given location
+func sunElevation(t time.Time, latitude float64, longitude float64) float64 {
+	rad := math.Pi / 180
+
+	// Days since J2000
+	n := float64(t.UTC().UnixNano())/float64(24*time.Hour) - 10957.5
+
+	meanLong := math.Mod(280.460+0.9856474*n, 360)
+	anomaly := math.Mod(357.528+0.9856003*n, 360) * rad
+	eclipticLong := (meanLong + 1.915*math.Sin(anomaly) + 0.020*math.Sin(2*anomaly)) * rad
+	obliquity := (23.439 - 0.0000004*n) * rad
+
+	ascension := math.Atan2(math.Cos(obliquity)*math.Sin(eclipticLong), math.Cos(eclipticLong)) / rad
+	declination := math.Asin(math.Sin(obliquity) * math.Sin(eclipticLong))
+
+	siderealTime := math.Mod(280.46061837+360.98564736629*n, 360)
+	hourAngle := (siderealTime + longitude - ascension) * rad
+
+	lat := latitude * rad
+	return math.Asin(math.Sin(lat)*math.Sin(declination)+math.Cos(lat)*math.Cos(declination)*math.Cos(hourAngle)) / rad
+}
@@ -0,0 +1,122 @@
+package sportsmatrix
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/robbydyer/sports/pkg/board"
+)
+
+// BoardHealth describes the recent activity of a board
+type BoardHealth struct {
+	Name          string
+	Enabled       bool
+	LastRender    time.Time
+	LastError     string
+	LastErrorTime time.Time
+	DataUpdated   time.Time
+	SkipReason    string
+}
+
+type healthTracker struct {
+	boards    map[string]*boardActivity
+	heartbeat time.Time
+	sync.Mutex
+}
+
+type boardActivity struct {
+	lastRender    time.Time
+	lastError     error
+	lastErrorTime time.Time
+}
+
+func newHealthTracker() *healthTracker {
+	return &healthTracker{
+		boards:    make(map[string]*boardActivity),
+		heartbeat: time.Now(),
+	}
+}
+
+// beat records that the render loop is making progress
+func (h *healthTracker) beat() {
+	h.Lock()
+	defer h.Unlock()
+	h.heartbeat = time.Now()
+}
+
+func (h *healthTracker) lastBeat() time.Time {
+	h.Lock()
+	defer h.Unlock()
+	return h.heartbeat
+}
+
+// rendered records the result of a board's render
+func (h *healthTracker) rendered(name string, err error) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.heartbeat = time.Now()
+
+	a, ok := h.boards[name]
+	if !ok {
+		a = &boardActivity{}
+		h.boards[name] = a
+	}
+
+	if err == nil {
+		a.lastRender = h.heartbeat
+		return
+	}
+	if !errors.Is(err, context.Canceled) {
+		a.lastError = err
+		a.lastErrorTime = h.heartbeat
+	}
+}
+
+func (h *healthTracker) activity(name string) boardActivity {
+	h.Lock()
+	defer h.Unlock()
+
+	if a, ok := h.boards[name]; ok {
+		return *a
+	}
+
+	return boardActivity{}
+}
+
+// Health returns the health of every board
+func (s *SportsMatrix) Health() []*BoardHealth {
+	healths := make([]*BoardHealth, 0, len(s.boards))
+	for _, b := range s.boards {
+		a := s.health.activity(b.Name())
+		h := &BoardHealth{
+			Name:          b.Name(),
+			Enabled:       b.Enabled(),
+			LastRender:    a.lastRender,
+			LastErrorTime: a.lastErrorTime,
+		}
+		if a.lastError != nil {
+			h.LastError = a.lastError.Error()
+		}
+
+		if d, ok := b.(board.Diagnoser); ok {
+			diag := d.Diagnostics()
+			h.DataUpdated = diag.DataUpdated
+			h.SkipReason = diag.SkipReason
+		}
+
+		// A board that was turned back on during its off hours isn't being skipped
+		switch {
+		case h.Enabled && h.SkipReason == board.SkipOffHours:
+			h.SkipReason = ""
+		case !h.Enabled && h.SkipReason != board.SkipOffHours:
+			h.SkipReason = board.SkipDisabled
+		}
+
+		healths = append(healths, h)
+	}
+
+	return healths
+}
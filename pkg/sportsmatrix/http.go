@@ -47,6 +47,7 @@ func (s *SportsMatrix) startHTTP() chan error {
 	router := mux.NewRouter()
 
 	registeredPaths := make(map[string]struct{})
+	publicPaths := make(map[string]struct{})
 
 	register := func(name string, h *board.HTTPHandler) {
 		if _, ok := registeredPaths[h.Path]; ok {
@@ -59,7 +60,10 @@ func (s *SportsMatrix) startHTTP() chan error {
 			h.Path = filepath.Join("/api", h.Path)
 		}
 		s.log.Info("registering http handler", zap.String("name", name), zap.String("path", h.Path))
-		router.HandleFunc(h.Path, h.Handler)
+		router.HandleFunc(h.Path, twirphelpers.AuthorizeHandler(h))
+		if h.Public {
+			publicPaths[h.Path] = struct{}{}
+		}
 		s.httpEndpoints = append(s.httpEndpoints, h.Path)
 	}
 
@@ -67,7 +71,10 @@ func (s *SportsMatrix) startHTTP() chan error {
 		register("sportsmatrix", h)
 	}
 
+	router.Handle("/metrics", s.metricsHandler())
+
 	allBoards := append(s.boards, s.betweenBoards...)
+	allBoards = append(allBoards, s.zoneBoards()...)
 
 	rpcPaths := make(map[string]struct{})
 
@@ -118,11 +125,6 @@ func (s *SportsMatrix) startHTTP() chan error {
 		dupe[e] = struct{}{}
 	}
 
-	s.server = http.Server{
-		Addr:    fmt.Sprintf(":%d", s.cfg.HTTPListenPort),
-		Handler: router,
-	}
-
 	// RPC server
 	svr := &Server{
 		sm: s,
@@ -141,6 +143,19 @@ func (s *SportsMatrix) startHTTP() chan error {
 	// router.Handle(twirpHandler.PathPrefix(), twirpHandler)
 	router.PathPrefix(twirpHandler.PathPrefix()).Handler(twirpHandler)
 
+	configHandler := pb.NewConfigServer(&ConfigServer{sm: s},
+		twirp.WithServerPathPrefix(""),
+		twirp.ChainHooks(
+			twirphelpers.GetDefaultHooks(nil, s.log),
+		),
+	)
+	s.log.Info("register RPC Handler",
+		zap.String("board", "Config"),
+		zap.String("path", configHandler.PathPrefix()),
+	)
+	router.PathPrefix(configHandler.PathPrefix()).Handler(configHandler)
+
+	var webUI *mux.Route
 	if s.cfg.ServeWebUI {
 		filesys := fs.FS(assets)
 		web, err := fs.Sub(filesys, "assets/web")
@@ -150,23 +165,62 @@ func (s *SportsMatrix) startHTTP() chan error {
 			return errChan
 		}
 		s.log.Info("serving web UI", zap.Int("port", s.cfg.HTTPListenPort))
-		router.PathPrefix("/").Handler(http.FileServer(EmbedDir{http.FS(web)}))
+		webUI = router.PathPrefix("/").Handler(http.FileServer(EmbedDir{http.FS(web)}))
+	}
+
+	s.server = http.Server{
+		Addr:    fmt.Sprintf(":%d", s.cfg.HTTPListenPort),
+		Handler: s.auth.Middleware(s.recordState(router), publicRequest(router, webUI, publicPaths)),
 	}
 
-	s.log.Info("Starting http server")
-	go func() {
-		errChan <- s.server.ListenAndServe()
-	}()
+	if tlsCfg := s.tlsConfig(); tlsCfg != nil {
+		s.server.TLSConfig = tlsCfg
+
+		if s.cfg.TLS.RedirectPort > 0 {
+			s.redirectServer = &http.Server{
+				Addr:    fmt.Sprintf(":%d", s.cfg.TLS.RedirectPort),
+				Handler: s.redirectHandler(),
+			}
+			s.log.Info("Starting http to https redirect server", zap.Int("port", s.cfg.TLS.RedirectPort))
+			go func() {
+				errChan <- s.redirectServer.ListenAndServe()
+			}()
+		}
+
+		s.log.Info("Starting https server")
+		go func() {
+			errChan <- s.server.ListenAndServeTLS("", "")
+		}()
+	} else {
+		s.log.Info("Starting http server")
+		go func() {
+			errChan <- s.server.ListenAndServe()
+		}()
+	}
 
 	time.Sleep(1 * time.Second)
 
 	return errChan
 }
 
+// publicRequest returns a func that reports requests that are served without a token: the web UI's
+// assets and the public endpoints, which the web board kiosk uses as it can't log in
+func publicRequest(router *mux.Router, webUI *mux.Route, paths map[string]struct{}) func(*http.Request) bool {
+	return func(req *http.Request) bool {
+		if _, ok := paths[req.URL.Path]; ok {
+			return true
+		}
+
+		var match mux.RouteMatch
+		return webUI != nil && router.Match(req, &match) && match.Route == webUI
+	}
+}
+
 func (s *SportsMatrix) httpHandlers() []*board.HTTPHandler {
 	return []*board.HTTPHandler{
 		{
-			Path: "/api/version",
+			Path:     "/api/version",
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				_, _ = w.Write([]byte(version))
 			},
@@ -180,7 +234,9 @@ func (s *SportsMatrix) httpHandlers() []*board.HTTPHandler {
 					s.log.Error("failed /api/screenon",
 						zap.Error(err),
 					)
+					return
 				}
+				s.storeState(screenOnState, true)
 			},
 		},
 		{
@@ -192,11 +248,14 @@ func (s *SportsMatrix) httpHandlers() []*board.HTTPHandler {
 					s.log.Error("failed /api/screenoff",
 						zap.Error(err),
 					)
+					return
 				}
+				s.storeState(screenOnState, false)
 			},
 		},
 		{
-			Path: "/api/status",
+			Path:     "/api/status",
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				w.Header().Set("Content-Type", "text/plain")
 				if s.screenIsOn.Load() {
@@ -212,6 +271,7 @@ func (s *SportsMatrix) httpHandlers() []*board.HTTPHandler {
 				s.Lock()
 				defer s.Unlock()
 				s.webBoardOn <- struct{}{}
+				s.storeState(webBoardOnState, true)
 			},
 		},
 		{
@@ -220,10 +280,12 @@ func (s *SportsMatrix) httpHandlers() []*board.HTTPHandler {
 				s.Lock()
 				defer s.Unlock()
 				s.webBoardOff <- struct{}{}
+				s.storeState(webBoardOnState, false)
 			},
 		},
 		{
-			Path: "/api/webboardstatus",
+			Path:     "/api/webboardstatus",
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				w.Header().Set("Content-Type", "text/plain")
 				if s.webBoardIsOn.Load() {
@@ -239,8 +301,10 @@ func (s *SportsMatrix) httpHandlers() []*board.HTTPHandler {
 				s.Lock()
 				defer s.Unlock()
 				s.log.Info("disabling all boards")
-				for _, board := range s.boards {
-					board.Disable()
+				for _, b := range s.boards {
+					if b.Disable() {
+						recordEnabled(req.Context(), b)
+					}
 				}
 				s.log.Info("all boards disabled")
 			},
@@ -250,8 +314,10 @@ func (s *SportsMatrix) httpHandlers() []*board.HTTPHandler {
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				s.Lock()
 				defer s.Unlock()
-				for _, board := range s.boards {
-					board.Enable()
+				for _, b := range s.boards {
+					if b.Enable() {
+						recordEnabled(req.Context(), b)
+					}
 				}
 				s.log.Info("all boards enabled")
 			},
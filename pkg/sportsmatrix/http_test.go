@@ -0,0 +1,41 @@
+package sportsmatrix
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicRequest(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {})
+
+	router := mux.NewRouter()
+	router.Handle("/api/imgcanvas/stream", h)
+	router.Handle("/api/screenoff", h)
+	router.Handle("/metrics", h)
+	router.PathPrefix("/matrix.v1.Sportsmatrix/").Handler(h)
+
+	public := map[string]struct{}{
+		"/api/imgcanvas/stream": {},
+	}
+
+	isPublic := func(path string) bool {
+		return publicRequest(router, nil, public)(httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	require.True(t, isPublic("/api/imgcanvas/stream"))
+	require.False(t, isPublic("/board"))
+
+	webUI := router.PathPrefix("/").Handler(h)
+	isPublic = func(path string) bool {
+		return publicRequest(router, webUI, public)(httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	require.True(t, isPublic("/board"))
+	require.True(t, isPublic("/static/js/main.js"))
+	require.True(t, isPublic("/api/imgcanvas/stream"))
+	require.False(t, isPublic("/api/screenoff"))
+	require.False(t, isPublic("/metrics"))
+	require.False(t, isPublic("/matrix.v1.Sportsmatrix/Jump"))
+}
@@ -0,0 +1,80 @@
+package sportsmatrix
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/robbydyer/sports/pkg/board"
+	"github.com/robbydyer/sports/pkg/metrics"
+)
+
+var (
+	boardRenderSeconds = metrics.NewHistogram("sportsmatrix_board_render_duration_seconds",
+		"Time from a board starting until its first frame is rendered",
+		metrics.DefBuckets,
+		"board",
+	)
+	boardDisplaySeconds = metrics.NewCounter("sportsmatrix_board_display_seconds_total",
+		"Total time each board has been displayed",
+		"board",
+	)
+	boardErrors = metrics.NewCounter("sportsmatrix_board_errors_total",
+		"Errors returned when rendering each board",
+		"board",
+	)
+	currentBoard = metrics.NewGauge("sportsmatrix_current_board",
+		"Set to 1 for the board currently shown in the main rotation",
+		"board",
+	)
+	screenOn = metrics.NewGauge("sportsmatrix_screen_on",
+		"Set to 1 when the screen is on",
+	)
+)
+
+// timedCanvas records how long a board takes to render its first frame
+type timedCanvas struct {
+	board.Canvas
+	boardName string
+	start     time.Time
+	rendered  bool
+}
+
+func (s *SportsMatrix) metricsHandler() http.Handler {
+	h := metrics.Handler()
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		screenOn.SetBool(s.screenIsOn.Load())
+		h.ServeHTTP(w, req)
+	})
+}
+
+// timedCanvases wraps the canvases that a board renders to for recording render times
+func timedCanvases(b board.Board, canvases []board.Canvas) []board.Canvas {
+	start := time.Now()
+
+	wrapped := make([]board.Canvas, 0, len(canvases))
+	for _, canvas := range canvases {
+		// Boards render to their own copy of scroll canvases
+		if canvas.Scrollable() {
+			wrapped = append(wrapped, canvas)
+			continue
+		}
+		wrapped = append(wrapped, &timedCanvas{
+			Canvas:    canvas,
+			boardName: b.Name(),
+			start:     start,
+		})
+	}
+
+	return wrapped
+}
+
+// Render ...
+func (c *timedCanvas) Render(ctx context.Context) error {
+	if !c.rendered {
+		c.rendered = true
+		boardRenderSeconds.Observe(time.Since(c.start).Seconds(), c.boardName)
+	}
+
+	return c.Canvas.Render(ctx)
+}
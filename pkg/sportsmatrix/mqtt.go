@@ -0,0 +1,410 @@
+package sportsmatrix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/robbydyer/sports/internal/proto/sportsmatrix"
+	"github.com/robbydyer/sports/pkg/board"
+	"github.com/robbydyer/sports/pkg/sportboard"
+)
+
+const (
+	mqttOn      = "ON"
+	mqttOff     = "OFF"
+	mqttOnline  = "online"
+	mqttOffline = "offline"
+)
+
+var (
+	defaultMQTTPublishInterval = 5 * time.Second
+	mqttCommandTimeout         = 10 * time.Second
+	mqttIDReplacer             = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// MQTTConfig connects the matrix to an MQTT broker, for home automation such as Home Assistant
+type MQTTConfig struct {
+	publishInterval time.Duration
+	Broker          string       `json:"broker"`
+	ClientID        string       `json:"clientID"`
+	Username        string       `json:"username"`
+	Password        string       `json:"password"`
+	TopicPrefix     string       `json:"topicPrefix"`
+	Discovery       *atomic.Bool `json:"discovery"`
+	DiscoveryPrefix string       `json:"discoveryPrefix"`
+	PublishInterval string       `json:"publishInterval"`
+}
+
+type mqttBridge struct {
+	sm        *SportsMatrix
+	server    *Server
+	cfg       *MQTTConfig
+	client    paho.Client
+	log       *zap.Logger
+	changed   chan struct{}
+	published map[string]string
+	sync.Mutex
+}
+
+type mqttDevice struct {
+	Identifiers  []string `json:"identifiers"`
+	Name         string   `json:"name"`
+	Manufacturer string   `json:"manufacturer"`
+	SWVersion    string   `json:"sw_version"`
+}
+
+type mqttDiscovery struct {
+	Name              string      `json:"name"`
+	UniqueID          string      `json:"unique_id"`
+	Icon              string      `json:"icon,omitempty"`
+	StateTopic        string      `json:"state_topic,omitempty"`
+	CommandTopic      string      `json:"command_topic,omitempty"`
+	AvailabilityTopic string      `json:"availability_topic"`
+	PayloadPress      string      `json:"payload_press,omitempty"`
+	Options           []string    `json:"options,omitempty"`
+	Device            *mqttDevice `json:"device"`
+}
+
+// SetDefaults ...
+func (c *MQTTConfig) SetDefaults() {
+	if c.ClientID == "" {
+		c.ClientID = "sportsmatrix"
+	}
+	if c.TopicPrefix == "" {
+		c.TopicPrefix = "sportsmatrix"
+	}
+	c.TopicPrefix = strings.TrimSuffix(c.TopicPrefix, "/")
+	if c.Discovery == nil {
+		c.Discovery = atomic.NewBool(true)
+	}
+	if c.DiscoveryPrefix == "" {
+		c.DiscoveryPrefix = "homeassistant"
+	}
+	if c.PublishInterval != "" {
+		d, err := time.ParseDuration(c.PublishInterval)
+		if err != nil {
+			c.publishInterval = defaultMQTTPublishInterval
+		} else {
+			c.publishInterval = d
+		}
+	} else {
+		c.publishInterval = defaultMQTTPublishInterval
+	}
+}
+
+func (c *MQTTConfig) topic(parts ...string) string {
+	return strings.Join(append([]string{c.TopicPrefix}, parts...), "/")
+}
+
+// mqttID converts a name to something usable in topics and Home Assistant IDs
+func mqttID(name string) string {
+	return strings.Trim(mqttIDReplacer.ReplaceAllString(strings.ToLower(name), "_"), "_")
+}
+
+func mqttBool(b bool) string {
+	if b {
+		return mqttOn
+	}
+	return mqttOff
+}
+
+// startMQTT connects to the MQTT broker. The connection is retried in the background
+// until the context is canceled
+func (s *SportsMatrix) startMQTT(ctx context.Context) {
+	cfg := s.cfg.MQTT
+	if cfg == nil || cfg.Broker == "" {
+		return
+	}
+
+	m := &mqttBridge{
+		sm:        s,
+		server:    &Server{sm: s},
+		cfg:       cfg,
+		log:       s.log,
+		changed:   make(chan struct{}, 1),
+		published: make(map[string]string),
+	}
+
+	opts := paho.NewClientOptions().
+		AddBroker(cfg.Broker).
+		SetClientID(cfg.ClientID).
+		SetUsername(cfg.Username).
+		SetPassword(cfg.Password).
+		SetAutoReconnect(true).
+		SetConnectRetry(true).
+		SetConnectRetryInterval(10*time.Second).
+		SetWill(cfg.topic("availability"), mqttOffline, 0, true).
+		SetOnConnectHandler(m.onConnect).
+		SetConnectionLostHandler(func(c paho.Client, err error) {
+			m.log.Error("lost connection to MQTT broker", zap.Error(err))
+		})
+
+	m.client = paho.NewClient(opts)
+
+	s.log.Info("connecting to MQTT broker",
+		zap.String("broker", cfg.Broker),
+		zap.String("topic prefix", cfg.TopicPrefix),
+	)
+	m.client.Connect()
+
+	s.mqtt = m
+
+	go m.serve(ctx)
+}
+
+// stateChanged triggers publishing the current state
+func (m *mqttBridge) stateChanged() {
+	select {
+	case m.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (m *mqttBridge) serve(ctx context.Context) {
+	ticker := time.NewTicker(m.cfg.publishInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if m.client.IsConnectionOpen() {
+				m.client.Publish(m.cfg.topic("availability"), 0, true, mqttOffline).WaitTimeout(time.Second)
+			}
+			m.client.Disconnect(250)
+			return
+		case <-ticker.C:
+		case <-m.changed:
+		}
+
+		if m.client.IsConnectionOpen() {
+			m.publishState(false)
+		}
+	}
+}
+
+// onConnect subscribes to the command topics and publishes everything, as the broker may have restarted
+func (m *mqttBridge) onConnect(c paho.Client) {
+	m.log.Info("connected to MQTT broker")
+
+	// Subscribing from within the connect handler must not wait for the broker
+	c.Subscribe(m.cfg.topic("+", "set"), 0, m.handleCommand)
+	c.Subscribe(m.cfg.topic("boards", "+", "set"), 0, m.handleCommand)
+
+	c.Publish(m.cfg.topic("availability"), 0, true, mqttOnline)
+
+	if m.cfg.Discovery.Load() {
+		m.publishDiscovery()
+	}
+	m.publishState(true)
+}
+
+func (m *mqttBridge) allBoards() []board.Board {
+	boards := append([]board.Board{}, m.sm.boards...)
+	boards = append(boards, m.sm.betweenBoards...)
+	return append(boards, m.sm.zoneBoards()...)
+}
+
+// state returns the payload for each state topic
+func (m *mqttBridge) state() map[string]string {
+	state := map[string]string{
+		m.cfg.topic("screen", "state"): mqttBool(m.sm.screenIsOn.Load()),
+		m.cfg.topic("board", "state"):  m.sm.current.Load(),
+	}
+
+	liveOnly := false
+	for _, b := range m.allBoards() {
+		if sb, ok := b.(*sportboard.SportBoard); ok && sb.LiveOnly() {
+			liveOnly = true
+		}
+
+		topic := m.cfg.topic("boards", mqttID(b.Name()), "state")
+		// Boards can share a name, they're reported as enabled if any of them are
+		if state[topic] != mqttOn {
+			state[topic] = mqttBool(b.Enabled())
+		}
+	}
+	state[m.cfg.topic("live_only", "state")] = mqttBool(liveOnly)
+
+	return state
+}
+
+// publishState publishes the state topics that changed since they were last published
+func (m *mqttBridge) publishState(all bool) {
+	m.Lock()
+	defer m.Unlock()
+
+	for topic, payload := range m.state() {
+		if prev, ok := m.published[topic]; ok && prev == payload && !all {
+			continue
+		}
+		m.client.Publish(topic, 0, true, payload)
+		m.published[topic] = payload
+	}
+}
+
+func (m *mqttBridge) publishDiscovery() {
+	device := &mqttDevice{
+		Identifiers:  []string{m.cfg.ClientID},
+		Name:         "Sportsmatrix",
+		Manufacturer: "sportsmatrix",
+		SWVersion:    version,
+	}
+	availability := m.cfg.topic("availability")
+
+	entities := map[string]*mqttDiscovery{
+		"switch/screen": {
+			Name:         "Screen",
+			Icon:         "mdi:television",
+			StateTopic:   m.cfg.topic("screen", "state"),
+			CommandTopic: m.cfg.topic("screen", "set"),
+		},
+		"switch/live_only": {
+			Name:         "Live Games Only",
+			Icon:         "mdi:broadcast",
+			StateTopic:   m.cfg.topic("live_only", "state"),
+			CommandTopic: m.cfg.topic("live_only", "set"),
+		},
+		"button/next": {
+			Name:         "Next Board",
+			Icon:         "mdi:skip-next",
+			CommandTopic: m.cfg.topic("next", "set"),
+		},
+		"button/enable_all": {
+			Name:         "Enable All Boards",
+			CommandTopic: m.cfg.topic("all", "set"),
+			PayloadPress: mqttOn,
+		},
+		"button/disable_all": {
+			Name:         "Disable All Boards",
+			CommandTopic: m.cfg.topic("all", "set"),
+			PayloadPress: mqttOff,
+		},
+	}
+
+	var names []string
+	seen := make(map[string]struct{})
+	for _, b := range m.allBoards() {
+		id := mqttID(b.Name())
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		names = append(names, b.Name())
+
+		entities["switch/board_"+id] = &mqttDiscovery{
+			Name:         fmt.Sprintf("%s Board", b.Name()),
+			StateTopic:   m.cfg.topic("boards", id, "state"),
+			CommandTopic: m.cfg.topic("boards", id, "set"),
+		}
+	}
+
+	entities["select/board"] = &mqttDiscovery{
+		Name:         "Current Board",
+		Icon:         "mdi:view-carousel",
+		StateTopic:   m.cfg.topic("board", "state"),
+		CommandTopic: m.cfg.topic("jump", "set"),
+		Options:      names,
+	}
+
+	for key, e := range entities {
+		parts := strings.SplitN(key, "/", 2)
+		e.UniqueID = fmt.Sprintf("%s_%s", m.cfg.ClientID, parts[1])
+		e.AvailabilityTopic = availability
+		e.Device = device
+
+		payload, err := json.Marshal(e)
+		if err != nil {
+			m.log.Error("failed to marshal MQTT discovery", zap.Error(err))
+			continue
+		}
+		topic := strings.Join([]string{m.cfg.DiscoveryPrefix, parts[0], m.cfg.ClientID, parts[1], "config"}, "/")
+		m.client.Publish(topic, 0, true, payload)
+	}
+}
+
+// handleCommand maps command topics onto the Sportsmatrix RPCs
+func (m *mqttBridge) handleCommand(c paho.Client, msg paho.Message) {
+	topic := strings.TrimPrefix(msg.Topic(), m.cfg.TopicPrefix+"/")
+	payload := strings.TrimSpace(string(msg.Payload()))
+
+	m.log.Info("received MQTT command",
+		zap.String("topic", msg.Topic()),
+		zap.String("payload", payload),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), mqttCommandTimeout)
+	defer cancel()
+
+	if m.sm.stateRecorder != nil {
+		ctx = board.WithStateRecorder(ctx, m.sm.stateRecorder)
+	}
+
+	if err := m.command(ctx, topic, payload); err != nil {
+		m.log.Error("MQTT command failed",
+			zap.String("topic", msg.Topic()),
+			zap.Error(err),
+		)
+	}
+
+	m.stateChanged()
+}
+
+func (m *mqttBridge) command(ctx context.Context, topic string, payload string) error {
+	on := strings.EqualFold(payload, mqttOn)
+
+	switch topic {
+	case "screen/set":
+		if on {
+			_, err := m.server.ScreenOn(ctx, &emptypb.Empty{})
+			return err
+		}
+		_, err := m.server.ScreenOff(ctx, &emptypb.Empty{})
+		return err
+	case "jump/set":
+		_, err := m.server.Jump(ctx, &pb.JumpReq{Board: payload})
+		return err
+	case "next/set":
+		_, err := m.server.NextBoard(ctx, &emptypb.Empty{})
+		return err
+	case "all/set":
+		_, err := m.server.SetAll(ctx, &pb.SetAllReq{Enabled: on})
+		return err
+	case "live_only/set":
+		_, err := m.server.SetLiveOnly(ctx, &pb.LiveOnlyReq{LiveOnly: on})
+		return err
+	}
+
+	if strings.HasPrefix(topic, "boards/") && strings.HasSuffix(topic, "/set") {
+		id := strings.TrimSuffix(strings.TrimPrefix(topic, "boards/"), "/set")
+		found := false
+		for _, b := range m.allBoards() {
+			if mqttID(b.Name()) != id {
+				continue
+			}
+			found = true
+			if on {
+				if b.Enable() {
+					recordEnabled(ctx, b)
+				}
+			} else if b.Disable() {
+				recordEnabled(ctx, b)
+			}
+		}
+		if !found {
+			return fmt.Errorf("no board with ID %s", id)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unknown command topic %s", topic)
+}
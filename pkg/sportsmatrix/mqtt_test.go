@@ -0,0 +1,281 @@
+package sportsmatrix
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/robbydyer/sports/pkg/board"
+)
+
+// testBroker is a minimal MQTT 3.1.1 broker that supports QoS 0 and retained messages
+type testBroker struct {
+	listener net.Listener
+	subs     map[net.Conn][]string
+	retained map[string][]byte
+	sync.Mutex
+}
+
+func newTestBroker(t *testing.T) *testBroker {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	b := &testBroker{
+		listener: l,
+		subs:     make(map[net.Conn][]string),
+		retained: make(map[string][]byte),
+	}
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go b.serve(conn)
+		}
+	}()
+
+	return b
+}
+
+func (b *testBroker) addr() string {
+	return "tcp://" + b.listener.Addr().String()
+}
+
+func (b *testBroker) close() {
+	_ = b.listener.Close()
+}
+
+func (b *testBroker) serve(conn net.Conn) {
+	defer func() {
+		b.Lock()
+		delete(b.subs, conn)
+		b.Unlock()
+		_ = conn.Close()
+	}()
+
+	r := bufio.NewReader(conn)
+	for {
+		header, err := r.ReadByte()
+		if err != nil {
+			return
+		}
+		length, err := binary.ReadUvarint(r)
+		if err != nil {
+			return
+		}
+		body := make([]byte, length)
+		if _, err := io.ReadFull(r, body); err != nil {
+			return
+		}
+
+		switch header >> 4 {
+		case 1: // CONNECT
+			_, _ = conn.Write([]byte{0x20, 0x02, 0x00, 0x00})
+		case 3: // PUBLISH
+			topic, rest := readMQTTString(body)
+			if qos := (header >> 1) & 0x03; qos > 0 {
+				_, _ = conn.Write([]byte{0x40, 0x02, rest[0], rest[1]})
+				rest = rest[2:]
+			}
+			b.publish(topic, rest, header&0x01 == 1)
+		case 8: // SUBSCRIBE
+			id := body[:2]
+			rest := body[2:]
+			var filters []string
+			for len(rest) > 0 {
+				var filter string
+				filter, rest = readMQTTString(rest)
+				filters = append(filters, filter)
+				rest = rest[1:]
+			}
+			b.Lock()
+			b.subs[conn] = append(b.subs[conn], filters...)
+			ack := []byte{0x90, byte(2 + len(filters)), id[0], id[1]}
+			ack = append(ack, make([]byte, len(filters))...)
+			_, _ = conn.Write(ack)
+			for topic, payload := range b.retained {
+				for _, f := range filters {
+					if mqttTopicMatches(f, topic) {
+						_, _ = conn.Write(mqttPublishPacket(topic, payload))
+						break
+					}
+				}
+			}
+			b.Unlock()
+		case 10: // UNSUBSCRIBE
+			_, _ = conn.Write([]byte{0xb0, 0x02, body[0], body[1]})
+		case 12: // PINGREQ
+			_, _ = conn.Write([]byte{0xd0, 0x00})
+		case 14: // DISCONNECT
+			return
+		}
+	}
+}
+
+func (b *testBroker) publish(topic string, payload []byte, retain bool) {
+	b.Lock()
+	defer b.Unlock()
+
+	if retain {
+		b.retained[topic] = payload
+	}
+
+	for conn, filters := range b.subs {
+		for _, f := range filters {
+			if mqttTopicMatches(f, topic) {
+				_, _ = conn.Write(mqttPublishPacket(topic, payload))
+				break
+			}
+		}
+	}
+}
+
+func readMQTTString(b []byte) (string, []byte) {
+	l := int(binary.BigEndian.Uint16(b))
+	return string(b[2 : 2+l]), b[2+l:]
+}
+
+func mqttPublishPacket(topic string, payload []byte) []byte {
+	body := make([]byte, 2, 2+len(topic)+len(payload))
+	binary.BigEndian.PutUint16(body, uint16(len(topic)))
+	body = append(body, topic...)
+	body = append(body, payload...)
+
+	length := make([]byte, binary.MaxVarintLen32)
+	n := binary.PutUvarint(length, uint64(len(body)))
+
+	packet := append([]byte{0x30}, length[:n]...)
+	return append(packet, body...)
+}
+
+func mqttTopicMatches(filter string, topic string) bool {
+	f := strings.Split(filter, "/")
+	t := strings.Split(topic, "/")
+	for i, part := range f {
+		if part == "#" {
+			return true
+		}
+		if i >= len(t) || (part != "+" && part != t[i]) {
+			return false
+		}
+	}
+	return len(f) == len(t)
+}
+
+func TestMQTT(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broker := newTestBroker(t)
+	defer broker.close()
+
+	logger := zaptest.NewLogger(t, zaptest.Level(zapcore.ErrorLevel))
+	cfg := &Config{
+		ServeWebUI:     false,
+		HTTPListenPort: 8080,
+		WebBoardWidth:  1,
+		MQTT: &MQTTConfig{
+			Broker:          broker.addr(),
+			PublishInterval: "50ms",
+		},
+	}
+
+	canvas := board.NewBlankCanvas(1, 1, logger)
+
+	var boards []board.Board
+	for _, name := range []string{"Scores", "Clock"} {
+		boards = append(boards, &TestBoard{
+			name:        name,
+			log:         logger,
+			enabled:     atomic.NewBool(true),
+			hasRendered: atomic.NewBool(false),
+		})
+	}
+
+	s, err := New(ctx, logger, cfg, []board.Canvas{canvas}, boards...)
+	require.NoError(t, err)
+	s.serveContext = ctx
+	s.current.Store("Scores")
+
+	var lock sync.Mutex
+	received := make(map[string]string)
+	client := paho.NewClient(paho.NewClientOptions().AddBroker(broker.addr()).SetClientID("test"))
+	token := client.Connect()
+	require.True(t, token.WaitTimeout(5*time.Second))
+	require.NoError(t, token.Error())
+	defer client.Disconnect(0)
+
+	token = client.Subscribe("#", 0, func(c paho.Client, msg paho.Message) {
+		lock.Lock()
+		defer lock.Unlock()
+		received[msg.Topic()] = string(msg.Payload())
+	})
+	require.True(t, token.WaitTimeout(5*time.Second))
+
+	waitFor := func(topic string, payload string) {
+		require.Eventually(t, func() bool {
+			lock.Lock()
+			defer lock.Unlock()
+			return received[topic] == payload
+		}, 5*time.Second, 10*time.Millisecond, "waiting for %s=%s", topic, payload)
+	}
+	command := func(topic string, payload string) {
+		require.True(t, client.Publish(topic, 0, false, payload).WaitTimeout(5*time.Second))
+	}
+
+	s.startMQTT(ctx)
+
+	waitFor("sportsmatrix/availability", mqttOnline)
+	waitFor("sportsmatrix/screen/state", mqttOn)
+	waitFor("sportsmatrix/board/state", "Scores")
+	waitFor("sportsmatrix/boards/clock/state", mqttOn)
+	waitFor("sportsmatrix/live_only/state", mqttOff)
+
+	require.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		_, ok := received["homeassistant/select/sportsmatrix/board/config"]
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+
+	lock.Lock()
+	var discovery mqttDiscovery
+	require.NoError(t, json.Unmarshal([]byte(received["homeassistant/select/sportsmatrix/board/config"]), &discovery))
+	lock.Unlock()
+	require.Equal(t, "sportsmatrix/jump/set", discovery.CommandTopic)
+	require.Equal(t, []string{"Scores", "Clock"}, discovery.Options)
+	require.Equal(t, "sportsmatrix/availability", discovery.AvailabilityTopic)
+
+	command("sportsmatrix/boards/clock/set", mqttOff)
+	waitFor("sportsmatrix/boards/clock/state", mqttOff)
+	require.False(t, boards[1].Enabled())
+	require.True(t, boards[0].Enabled())
+
+	command("sportsmatrix/all/set", mqttOff)
+	waitFor("sportsmatrix/boards/scores/state", mqttOff)
+	command("sportsmatrix/all/set", mqttOn)
+	waitFor("sportsmatrix/boards/scores/state", mqttOn)
+	waitFor("sportsmatrix/boards/clock/state", mqttOn)
+
+	command("sportsmatrix/screen/set", mqttOff)
+	waitFor("sportsmatrix/screen/state", mqttOff)
+	require.False(t, s.screenIsOn.Load())
+
+	cancel()
+	waitFor("sportsmatrix/availability", mqttOffline)
+}
@@ -0,0 +1,223 @@
+package sportsmatrix
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/board"
+)
+
+// Playlist is a named rotation of boards
+type Playlist struct {
+	Name       string           `json:"name"`
+	Boards     []*PlaylistBoard `json:"boards"`
+	StartTimes []string         `json:"startTimes"`
+}
+
+// PlaylistBoard is a board entry in a Playlist
+type PlaylistBoard struct {
+	dwell  time.Duration
+	Board  string `json:"board"`
+	Dwell  string `json:"dwell"`
+	Weight int    `json:"weight"`
+}
+
+type rotationEntry struct {
+	board board.Board
+	dwell time.Duration
+}
+
+// SetDefaults sets some sane defaults for the playlist
+func (p *Playlist) SetDefaults() {
+	for _, b := range p.Boards {
+		if b.Weight < 1 {
+			b.Weight = 1
+		}
+		if b.Dwell != "" {
+			d, err := time.ParseDuration(b.Dwell)
+			if err == nil {
+				b.dwell = d
+			}
+		}
+	}
+}
+
+func (c *Config) validatePlaylists() error {
+	for _, p := range c.Playlists {
+		for _, b := range p.Boards {
+			if b.Dwell == "" {
+				continue
+			}
+			if _, err := time.ParseDuration(b.Dwell); err != nil {
+				return fmt.Errorf("playlist %s: invalid dwell %q for board %s: %w", p.Name, b.Dwell, b.Board, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *SportsMatrix) getPlaylist(name string) *Playlist {
+	for _, p := range s.cfg.Playlists {
+		if strings.EqualFold(p.Name, name) {
+			return p
+		}
+	}
+
+	return nil
+}
+
+func (s *SportsMatrix) getBoard(name string) board.Board {
+	for _, b := range s.boards {
+		if strings.EqualFold(b.Name(), name) {
+			return b
+		}
+	}
+
+	return nil
+}
+
+// ActivePlaylist returns the name of the active playlist. An empty string means
+// all boards are being rotated in their default order
+func (s *SportsMatrix) ActivePlaylist() string {
+	return s.activePlaylist.Load()
+}
+
+// SetPlaylist switches the board rotation to the given playlist. An empty name
+// restores the default rotation of all boards.
+func (s *SportsMatrix) SetPlaylist(name string) error {
+	if name != "" {
+		p := s.getPlaylist(name)
+		if p == nil {
+			return fmt.Errorf("could not find playlist %s", name)
+		}
+		name = p.Name
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.activePlaylist.Load() == name {
+		return nil
+	}
+
+	s.log.Info("switching playlist",
+		zap.String("playlist", name),
+	)
+
+	s.activePlaylist.Store(name)
+
+	if s.currentBoardCancel != nil {
+		s.currentBoardCancel()
+	}
+
+	return nil
+}
+
+// rotation returns the order of boards to be rendered for a playlist. Weighted boards are
+// spread evenly through the rotation rather than being shown back to back.
+func (s *SportsMatrix) rotation(playlist string) []*rotationEntry {
+	p := s.getPlaylist(playlist)
+	if p == nil {
+		entries := make([]*rotationEntry, 0, len(s.boards))
+		for _, b := range s.boards {
+			entries = append(entries, &rotationEntry{board: b})
+		}
+		return entries
+	}
+
+	type weighted struct {
+		entry   *rotationEntry
+		weight  int
+		current int
+	}
+
+	var items []*weighted
+	total := 0
+	for _, pb := range p.Boards {
+		b := s.getBoard(pb.Board)
+		if b == nil {
+			s.log.Warn("playlist board does not exist",
+				zap.String("playlist", p.Name),
+				zap.String("board", pb.Board),
+			)
+			continue
+		}
+		items = append(items, &weighted{
+			entry: &rotationEntry{
+				board: b,
+				dwell: pb.dwell,
+			},
+			weight: pb.Weight,
+		})
+		total += pb.Weight
+	}
+
+	// Smooth weighted round-robin
+	entries := make([]*rotationEntry, 0, total)
+	for i := 0; i < total; i++ {
+		var best *weighted
+		for _, item := range items {
+			item.current += item.weight
+			if best == nil || item.current > best.current {
+				best = item
+			}
+		}
+		best.current -= total
+		entries = append(entries, best.entry)
+	}
+
+	return entries
+}
+
+// rotationBoards returns each board in the active rotation once, in order
+func (s *SportsMatrix) rotationBoards() []board.Board {
+	seen := make(map[board.Board]struct{})
+	var boards []board.Board
+	for _, entry := range s.rotation(s.activePlaylist.Load()) {
+		if _, ok := seen[entry.board]; ok {
+			continue
+		}
+		seen[entry.board] = struct{}{}
+		boards = append(boards, entry.board)
+	}
+
+	return boards
+}
+
+// doBoardDwell renders a board repeatedly until the dwell time has elapsed. With no
+// dwell time, the board is rendered once using its own timing.
+func (s *SportsMatrix) doBoardDwell(ctx context.Context, b board.Board, dwell time.Duration) error {
+	if dwell <= 0 {
+		return s.doBoard(ctx, b)
+	}
+
+	dwellCtx, cancel := context.WithTimeout(ctx, dwell)
+	defer cancel()
+
+	for {
+		start := time.Now()
+		err := s.doBoard(dwellCtx, b)
+
+		select {
+		case <-ctx.Done():
+			return context.Canceled
+		case <-dwellCtx.Done():
+			return nil
+		default:
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// A board that returns right away has nothing to show, so don't spin on it
+		if time.Since(start) < time.Second {
+			return nil
+		}
+	}
+}
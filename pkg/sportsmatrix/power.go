@@ -0,0 +1,22 @@
+package sportsmatrix
+
+// PowerMeter estimates the current drawn by the matrix
+type PowerMeter interface {
+	// Estimate returns the estimated current drawn by the last frame in milliamps, and
+	// whether the frame was dimmed to stay within the power limit
+	Estimate() (float64, bool)
+}
+
+// SetPowerMeter sets the PowerMeter used for reporting the estimated current draw
+func (s *SportsMatrix) SetPowerMeter(p PowerMeter) {
+	s.powerMeter = p
+}
+
+// PowerEstimate returns the estimated current drawn by the matrix in milliamps, and whether
+// frames are being dimmed to stay within the power limit. Returns 0 when there's no power limit
+func (s *SportsMatrix) PowerEstimate() (float64, bool) {
+	if s.powerMeter == nil {
+		return 0, false
+	}
+	return s.powerMeter.Estimate()
+}
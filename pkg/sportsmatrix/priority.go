@@ -0,0 +1,128 @@
+package sportsmatrix
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/board"
+)
+
+var defaultPriorityInterval = 30 * time.Second
+
+// nextPriorityBoard returns the first enabled board, in rotation order, that reports
+// having priority. Returns nil if no board has priority
+func (s *SportsMatrix) nextPriorityBoard(ctx context.Context) board.Board {
+	for _, b := range s.boards {
+		if !b.Enabled() {
+			continue
+		}
+		p, ok := b.(board.Prioritizer)
+		if !ok {
+			continue
+		}
+		if p.HasPriority(ctx) {
+			return b
+		}
+	}
+
+	return nil
+}
+
+func (s *SportsMatrix) getPriorityBoard() board.Board {
+	s.priorityLock.RLock()
+	defer s.priorityLock.RUnlock()
+	return s.priorityBoard
+}
+
+// setPriorityBoard stores the current priority board. Returns true if it changed
+func (s *SportsMatrix) setPriorityBoard(b board.Board) bool {
+	s.priorityLock.Lock()
+	defer s.priorityLock.Unlock()
+
+	if s.priorityBoard == b {
+		return false
+	}
+	s.priorityBoard = b
+
+	return true
+}
+
+// watchPriority polls the boards for priority. Whenever the board with priority changes,
+// the currently rendering board is canceled so that the serve loop can either switch to
+// the priority board or return to the normal rotation.
+func (s *SportsMatrix) watchPriority(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.priorityInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !s.screenIsOn.Load() || s.cfg.CombinedScroll.Load() {
+			continue
+		}
+
+		next := s.nextPriorityBoard(ctx)
+		if !s.setPriorityBoard(next) {
+			continue
+		}
+
+		if next != nil {
+			s.log.Info("board has taken priority, preempting current board",
+				zap.String("board", next.Name()),
+			)
+		} else {
+			s.log.Info("no boards have priority, returning to normal rotation")
+		}
+
+		s.Lock()
+		if s.currentBoardCancel != nil {
+			s.currentBoardCancel()
+		}
+		s.Unlock()
+	}
+}
+
+// servePriority renders the priority board until no board has priority
+func (s *SportsMatrix) servePriority(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		b := s.getPriorityBoard()
+		if b == nil {
+			return
+		}
+
+		s.log.Debug("rendering priority board",
+			zap.String("board", b.Name()),
+		)
+
+		s.currentBoardCtx, s.currentBoardCancel = context.WithCancel(ctx)
+		err := s.doBoard(s.currentBoardCtx, b)
+		s.currentBoardCancel()
+		if err != nil {
+			s.log.Error("failed to render priority board",
+				zap.String("board", b.Name()),
+				zap.Error(err),
+			)
+			return
+		}
+
+		// A board jump takes precedence over priority
+		if s.currentJump != "" {
+			return
+		}
+
+		// Re-check so we return to the normal rotation as soon as priority ends
+		s.setPriorityBoard(s.nextPriorityBoard(ctx))
+	}
+}
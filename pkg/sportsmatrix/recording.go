@@ -0,0 +1,150 @@
+package sportsmatrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
+	"github.com/robbydyer/sports/pkg/rgbrender"
+)
+
+var (
+	maxRecordingDuration = 10 * time.Minute
+	maxRecordingScale    = 16
+	// maxRecordingBytes limits the memory used by a recording. The frames, and the upscaled GIF
+	// made from them, are held in memory until the recording is saved
+	maxRecordingBytes = 128 * 1024 * 1024
+)
+
+// MatrixRecorder captures the frames rendered to the matrix
+type MatrixRecorder interface {
+	Geometry() (int, int)
+	Record(ctx context.Context, d time.Duration, maxFrames int) ([]*rgb.Frame, error)
+	StopRecording() bool
+}
+
+// SetMatrixRecorder sets the recorder used for recording the matrix output
+func (s *SportsMatrix) SetMatrixRecorder(r MatrixRecorder) {
+	s.recorder = r
+}
+
+// Record captures the matrix output for the given duration, or until StopRecording is
+// called, and saves it as an animated GIF upscaled by the given factor. A duration of 0
+// records until stopped, up to 10 minutes
+func (s *SportsMatrix) Record(ctx context.Context, d time.Duration, scale int, fileName string) error {
+	if s.recorder == nil {
+		return fmt.Errorf("recording is not supported")
+	}
+	if !s.recording.CAS(false, true) {
+		return fmt.Errorf("a recording is already in progress")
+	}
+	defer s.recording.Store(false)
+
+	return s.record(ctx, d, scale, fileName)
+}
+
+func (s *SportsMatrix) record(ctx context.Context, d time.Duration, scale int, fileName string) error {
+	if d <= 0 || d > maxRecordingDuration {
+		d = maxRecordingDuration
+	}
+	if scale < 1 {
+		scale = 1
+	}
+	if scale > maxRecordingScale {
+		scale = maxRecordingScale
+	}
+
+	s.log.Info("recording matrix",
+		zap.String("file", fileName),
+		zap.Duration("max duration", d),
+	)
+
+	frames, err := s.recorder.Record(ctx, d, maxRecordingFrames(s.recorder, scale))
+	if errors.Is(err, rgb.ErrRecordingFull) {
+		s.log.Warn("recording stopped early, as it used the most memory a recording may use",
+			zap.String("file", fileName),
+			zap.Int("frames", len(frames)),
+		)
+	} else if err != nil {
+		return err
+	}
+	if len(frames) < 1 {
+		return fmt.Errorf("no frames were rendered during the recording")
+	}
+
+	if err := rgbrender.SaveGif(rgb.FramesToGif(frames, scale), fileName); err != nil {
+		return err
+	}
+
+	s.log.Info("saved matrix recording",
+		zap.String("file", fileName),
+		zap.Int("frames", len(frames)),
+	)
+
+	return nil
+}
+
+// maxRecordingFrames returns how many frames fit in maxRecordingBytes. Each frame is kept as RGBA,
+// and upscaled to a paletted GIF frame when it's saved
+func maxRecordingFrames(r MatrixRecorder, scale int) int {
+	w, h := r.Geometry()
+	frameBytes := w * h * (4 + (scale * scale))
+	if frameBytes < 1 {
+		return 0
+	}
+
+	if frameBytes > maxRecordingBytes {
+		return 1
+	}
+
+	return maxRecordingBytes / frameBytes
+}
+
+// StartRecording records the matrix in the background to a new file in the recording directory.
+// It returns the name of the file that will be written
+func (s *SportsMatrix) StartRecording(d time.Duration, scale int) (string, error) {
+	if s.recorder == nil {
+		return "", fmt.Errorf("recording is not supported")
+	}
+	if !s.recording.CAS(false, true) {
+		return "", fmt.Errorf("a recording is already in progress")
+	}
+
+	dir := s.cfg.RecordingDir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	fileName := filepath.Join(dir, fmt.Sprintf("sportsmatrix-%s.gif", time.Now().Format("20060102-150405")))
+
+	ctx := s.serveContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	go func() {
+		defer s.recording.Store(false)
+		if err := s.record(ctx, d, scale, fileName); err != nil {
+			s.log.Error("failed to record matrix",
+				zap.String("file", fileName),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	return fileName, nil
+}
+
+// StopRecording stops the recording in progress
+func (s *SportsMatrix) StopRecording() error {
+	if s.recorder == nil || !s.recorder.StopRecording() {
+		return fmt.Errorf("no recording is in progress")
+	}
+
+	return nil
+}
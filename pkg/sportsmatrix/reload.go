@@ -0,0 +1,88 @@
+package sportsmatrix
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ConfigReloader reloads the config file and applies any changes to the running boards
+type ConfigReloader func(ctx context.Context) (*ReloadReport, error)
+
+// ReloadReport describes the result of a config reload. Each entry is the
+// json path of a config field, ie. "nhlConfig.watchTeams"
+type ReloadReport struct {
+	Applied         []string
+	RestartRequired []string
+	Errors          []string
+}
+
+// SetConfigReloader sets the func used to reload the config
+func (s *SportsMatrix) SetConfigReloader(r ConfigReloader) {
+	s.reloadLock.Lock()
+	defer s.reloadLock.Unlock()
+	s.configReloader = r
+}
+
+// ReloadConfig reloads the config file and applies changes to the running boards
+func (s *SportsMatrix) ReloadConfig(ctx context.Context) (*ReloadReport, error) {
+	s.reloadLock.Lock()
+	defer s.reloadLock.Unlock()
+
+	if s.configReloader == nil {
+		return nil, fmt.Errorf("config reloading is not supported")
+	}
+
+	return s.configReloader(ctx)
+}
+
+// ConfigEditor reads and updates the config for the Config RPC service
+type ConfigEditor interface {
+	// Config returns the effective config of every board, as JSON
+	Config() ([]byte, error)
+	// Schema returns a JSON schema describing the config
+	Schema() ([]byte, error)
+	// Update merges a partial config, as JSON, into the config file and reloads it. A change
+	// that doesn't validate returns an *InvalidConfigError
+	Update(ctx context.Context, patch []byte) (*ReloadReport, error)
+}
+
+// InvalidConfigError lists the problems with a config update
+type InvalidConfigError struct {
+	Problems []string
+}
+
+// Error ...
+func (e *InvalidConfigError) Error() string {
+	return fmt.Sprintf("invalid config: %s", strings.Join(e.Problems, "; "))
+}
+
+// SetConfigEditor sets the editor used by the Config RPC service
+func (s *SportsMatrix) SetConfigEditor(e ConfigEditor) {
+	s.reloadLock.Lock()
+	defer s.reloadLock.Unlock()
+	s.configEditor = e
+}
+
+// UpdateConfig merges a partial config into the config file and applies changes to the running boards
+func (s *SportsMatrix) UpdateConfig(ctx context.Context, patch []byte) (*ReloadReport, error) {
+	s.reloadLock.Lock()
+	defer s.reloadLock.Unlock()
+
+	if s.configEditor == nil {
+		return nil, fmt.Errorf("config editing is not supported")
+	}
+
+	return s.configEditor.Update(ctx, patch)
+}
+
+func (s *SportsMatrix) getConfigEditor() (ConfigEditor, error) {
+	s.reloadLock.Lock()
+	defer s.reloadLock.Unlock()
+
+	if s.configEditor == nil {
+		return nil, fmt.Errorf("config editing is not supported")
+	}
+
+	return s.configEditor, nil
+}
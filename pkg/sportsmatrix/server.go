@@ -2,6 +2,7 @@ package sportsmatrix
 
 import (
 	"context"
+	"errors"
 	"os"
 	"syscall"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	pb "github.com/robbydyer/sports/internal/proto/sportsmatrix"
+	"github.com/robbydyer/sports/pkg/board"
 	"github.com/robbydyer/sports/pkg/sportboard"
 )
 
@@ -31,6 +33,7 @@ func (s *Server) ScreenOn(ctx context.Context, req *emptypb.Empty) (*emptypb.Emp
 	if err := s.sm.ScreenOn(ctx); err != nil {
 		return &emptypb.Empty{}, twirp.NewError(twirp.Internal, "failed to turn screen on")
 	}
+	s.sm.storeState(screenOnState, true)
 	return &emptypb.Empty{}, nil
 }
 
@@ -39,6 +42,7 @@ func (s *Server) ScreenOff(ctx context.Context, req *emptypb.Empty) (*emptypb.Em
 	if err := s.sm.ScreenOff(ctx); err != nil {
 		return &emptypb.Empty{}, twirp.NewError(twirp.Internal, "failed to turn screen off")
 	}
+	s.sm.storeState(screenOnState, false)
 	return &emptypb.Empty{}, nil
 }
 
@@ -48,18 +52,36 @@ func (s *Server) SetAll(ctx context.Context, req *pb.SetAllReq) (*emptypb.Empty,
 	defer s.sm.Unlock()
 
 	if req.Enabled {
-		for _, board := range s.sm.boards {
-			board.Enable()
+		for _, b := range s.sm.boards {
+			if b.Enable() {
+				recordEnabled(ctx, b)
+			}
 		}
-		for _, board := range s.sm.betweenBoards {
-			board.Enable()
+		for _, b := range s.sm.betweenBoards {
+			if b.Enable() {
+				recordEnabled(ctx, b)
+			}
+		}
+		for _, b := range s.sm.zoneBoards() {
+			if b.Enable() {
+				recordEnabled(ctx, b)
+			}
 		}
 	} else {
-		for _, board := range s.sm.boards {
-			board.Disable()
+		for _, b := range s.sm.boards {
+			if b.Disable() {
+				recordEnabled(ctx, b)
+			}
+		}
+		for _, b := range s.sm.betweenBoards {
+			if b.Disable() {
+				recordEnabled(ctx, b)
+			}
 		}
-		for _, board := range s.sm.betweenBoards {
-			board.Disable()
+		for _, b := range s.sm.zoneBoards() {
+			if b.Disable() {
+				recordEnabled(ctx, b)
+			}
 		}
 	}
 
@@ -94,17 +116,25 @@ func (s *Server) SetStatus(ctx context.Context, req *pb.Status) (*emptypb.Empty,
 		}
 	}
 
+	// The web board follows the screen, so it's only a change to persist while the screen is on
 	if req.WebboardOn {
 		if !s.sm.webBoardIsOn.Load() {
 			s.sm.startWebBoard(ctx)
+			if req.ScreenOn {
+				s.sm.storeState(webBoardOnState, true)
+			}
 		}
 	} else {
 		if s.sm.webBoardIsOn.Load() {
 			s.sm.stopWebBoard()
+			if req.ScreenOn {
+				s.sm.storeState(webBoardOnState, false)
+			}
 		}
 	}
 
 	if s.sm.cfg.CombinedScroll.CAS(!req.CombinedScroll, req.CombinedScroll) {
+		board.RecordToggles(ctx, s.sm.cfg.CombinedScroll)
 		if _, err := s.ScreenOff(ctx, &emptypb.Empty{}); err != nil {
 			return nil, twirp.NewError(twirp.Internal, err.Error())
 		}
@@ -118,10 +148,14 @@ func (s *Server) SetStatus(ctx context.Context, req *pb.Status) (*emptypb.Empty,
 
 // GetStatus ...
 func (s *Server) GetStatus(ctx context.Context, req *emptypb.Empty) (*pb.Status, error) {
+	milliamps, limited := s.sm.PowerEstimate()
+
 	return &pb.Status{
-		ScreenOn:       s.sm.screenIsOn.Load(),
-		WebboardOn:     s.sm.webBoardIsOn.Load(),
-		CombinedScroll: s.sm.cfg.CombinedScroll.Load(),
+		ScreenOn:           s.sm.screenIsOn.Load(),
+		WebboardOn:         s.sm.webBoardIsOn.Load(),
+		CombinedScroll:     s.sm.cfg.CombinedScroll.Load(),
+		EstimatedMilliamps: milliamps,
+		PowerLimited:       limited,
 	}, nil
 }
 
@@ -159,11 +193,179 @@ func (s *Server) RestartService(ctx context.Context, req *emptypb.Empty) (*empty
 
 // SetLiveOnly sets the LiveOnly setting for SportBoards
 func (s *Server) SetLiveOnly(ctx context.Context, req *pb.LiveOnlyReq) (*emptypb.Empty, error) {
-	for _, board := range s.sm.boards {
-		if sportBoard, ok := board.(*sportboard.SportBoard); ok {
-			sportBoard.SetLiveOnly(req.LiveOnly)
+	for _, b := range append(s.sm.zoneBoards(), s.sm.boards...) {
+		if sportBoard, ok := b.(*sportboard.SportBoard); ok {
+			sportBoard.SetLiveOnly(ctx, req.LiveOnly)
 		}
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+// SetPlaylist switches the active board playlist
+func (s *Server) SetPlaylist(ctx context.Context, req *pb.PlaylistReq) (*emptypb.Empty, error) {
+	if err := s.sm.SetPlaylist(req.Name); err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetPlaylists lists the configured playlists
+func (s *Server) GetPlaylists(ctx context.Context, req *emptypb.Empty) (*pb.PlaylistsResp, error) {
+	resp := &pb.PlaylistsResp{
+		Active: s.sm.ActivePlaylist(),
+	}
+	for _, p := range s.sm.cfg.Playlists {
+		resp.Names = append(resp.Names, p.Name)
+	}
+
+	return resp, nil
+}
+
+// ReloadConfig reloads the config file and applies changes to the running boards
+func (s *Server) ReloadConfig(ctx context.Context, req *emptypb.Empty) (*pb.ReloadConfigResp, error) {
+	report, err := s.sm.ReloadConfig(ctx)
+	if err != nil {
+		return nil, twirp.NewError(twirp.Internal, err.Error())
+	}
+
+	return &pb.ReloadConfigResp{
+		Applied:         report.Applied,
+		RestartRequired: report.RestartRequired,
+		Errors:          report.Errors,
+	}, nil
+}
+
+// ResetState clears settings that were persisted from API changes
+func (s *Server) ResetState(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := s.sm.ResetState(); err != nil {
+		return nil, twirp.NewError(twirp.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Record starts recording the matrix output to an animated GIF
+func (s *Server) Record(ctx context.Context, req *pb.RecordReq) (*pb.RecordResp, error) {
+	fileName, err := s.sm.StartRecording(time.Duration(req.Seconds)*time.Second, int(req.Scale))
+	if err != nil {
+		return nil, twirp.NewError(twirp.FailedPrecondition, err.Error())
+	}
+
+	return &pb.RecordResp{
+		File: fileName,
+	}, nil
+}
+
+// StopRecording stops the recording in progress
+func (s *Server) StopRecording(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := s.sm.StopRecording(); err != nil {
+		return nil, twirp.NewError(twirp.FailedPrecondition, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetHealth returns the health of every board
+func (s *Server) GetHealth(ctx context.Context, req *emptypb.Empty) (*pb.HealthResp, error) {
+	resp := &pb.HealthResp{}
+
+	for _, h := range s.sm.Health() {
+		bh := &pb.BoardHealth{
+			Name:          h.Name,
+			Enabled:       h.Enabled,
+			LastRender:    formatHealthTime(h.LastRender),
+			LastError:     h.LastError,
+			LastErrorTime: formatHealthTime(h.LastErrorTime),
+			DataUpdated:   formatHealthTime(h.DataUpdated),
+			SkipReason:    h.SkipReason,
+		}
+		if !h.DataUpdated.IsZero() {
+			bh.DataAgeSeconds = time.Since(h.DataUpdated).Seconds()
+		}
+		resp.Boards = append(resp.Boards, bh)
+	}
+
+	return resp, nil
+}
+
+func formatHealthTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// SetBrightness sets the brightness of the matrix
+func (s *Server) SetBrightness(ctx context.Context, req *pb.BrightnessReq) (*emptypb.Empty, error) {
+	if err := s.sm.SetBrightness(int(req.Brightness)); err != nil {
+		return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetBrightness returns the brightness of the matrix
+func (s *Server) GetBrightness(ctx context.Context, req *emptypb.Empty) (*pb.BrightnessResp, error) {
+	return &pb.BrightnessResp{
+		Brightness: int32(s.sm.Brightness()),
+	}, nil
+}
+
+// ConfigServer implements the Config RPC service
+type ConfigServer struct {
+	sm *SportsMatrix
+}
+
+// GetConfig returns the effective config of every board
+func (s *ConfigServer) GetConfig(ctx context.Context, req *emptypb.Empty) (*pb.ConfigResp, error) {
+	editor, err := s.sm.getConfigEditor()
+	if err != nil {
+		return nil, twirp.NewError(twirp.Unimplemented, err.Error())
+	}
+
+	cfg, err := editor.Config()
+	if err != nil {
+		return nil, twirp.NewError(twirp.Internal, err.Error())
+	}
+
+	return &pb.ConfigResp{
+		Config: string(cfg),
+	}, nil
+}
+
+// GetConfigSchema returns a JSON schema describing the config
+func (s *ConfigServer) GetConfigSchema(ctx context.Context, req *emptypb.Empty) (*pb.ConfigSchemaResp, error) {
+	editor, err := s.sm.getConfigEditor()
+	if err != nil {
+		return nil, twirp.NewError(twirp.Unimplemented, err.Error())
+	}
+
+	schema, err := editor.Schema()
+	if err != nil {
+		return nil, twirp.NewError(twirp.Internal, err.Error())
+	}
+
+	return &pb.ConfigSchemaResp{
+		Schema: string(schema),
+	}, nil
+}
+
+// UpdateConfig merges a partial config into the config file, and applies changes to the running boards
+func (s *ConfigServer) UpdateConfig(ctx context.Context, req *pb.UpdateConfigReq) (*pb.ReloadConfigResp, error) {
+	report, err := s.sm.UpdateConfig(ctx, []byte(req.Config))
+	if err != nil {
+		var invalid *InvalidConfigError
+		if errors.As(err, &invalid) {
+			return nil, twirp.NewError(twirp.InvalidArgument, err.Error())
+		}
+		return nil, twirp.NewError(twirp.FailedPrecondition, err.Error())
+	}
+
+	return &pb.ReloadConfigResp{
+		Applied:         report.Applied,
+		RestartRequired: report.RestartRequired,
+		Errors:          report.Errors,
+	}, nil
+}
@@ -2,6 +2,7 @@ package sportsmatrix
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"sort"
@@ -9,13 +10,14 @@ import (
 	"sync"
 	"time"
 
-	"github.com/robfig/cron/v3"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/robbydyer/sports/pkg/board"
 	"github.com/robbydyer/sports/pkg/imgcanvas"
 	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
+	"github.com/robbydyer/sports/pkg/simclock"
+	"github.com/robbydyer/sports/pkg/twirphelpers"
 )
 
 var version = "noversion"
@@ -57,25 +59,59 @@ type SportsMatrix struct {
 	liveOnly           *atomic.Bool
 	scrollStatus       chan float64
 	scrollInProgress   *atomic.Bool
+	priorityBoard      board.Board
+	priorityLock       sync.RWMutex
+	activePlaylist     *atomic.String
+	configReloader     ConfigReloader
+	configEditor       ConfigEditor
+	reloadLock         sync.Mutex
+	stateRecorder      StateRecorder
+	zones              []*zone
+	frames             *canvasFrames
+	recorder           MatrixRecorder
+	recording          *atomic.Bool
+	health             *healthTracker
+	dimmer             Dimmer
+	brightness         *atomic.Int32
+	powerMeter         PowerMeter
+	current            *atomic.String
+	mqtt               *mqttBridge
+	auth               *twirphelpers.Auth
+	redirectServer     *http.Server
 	sync.Mutex
 }
 
 // Config ...
 type Config struct {
 	combinedScrollDelay   time.Duration
-	ServeWebUI            bool                `json:"serveWebUI"`
-	HTTPListenPort        int                 `json:"httpListenPort"`
-	HardwareConfig        *rgb.HardwareConfig `json:"hardwareConfig"`
-	RuntimeOptions        *rgb.RuntimeOptions `json:"runtimeOptions"`
-	ScreenOffTimes        []string            `json:"screenOffTimes"`
-	ScreenOnTimes         []string            `json:"screenOnTimes"`
-	WebBoardWidth         int                 `json:"webBoardWidth"`
-	WebBoardHeight        int                 `json:"webBoardHeight"`
-	LaunchWebBoard        bool                `json:"launchWebBoard"`
-	WebBoardUser          string              `json:"webBoardUser"`
-	CombinedScroll        *atomic.Bool        `json:"combinedScroll"`
-	CombinedScrollDelay   string              `json:"combinedScrollDelay"`
-	CombinedScrollPadding int                 `json:"combinedScrollPadding"`
+	priorityInterval      time.Duration
+	watchdogTimeout       time.Duration
+	ServeWebUI            bool                     `json:"serveWebUI"`
+	HTTPListenPort        int                      `json:"httpListenPort"`
+	HardwareConfig        *rgb.HardwareConfig      `json:"hardwareConfig"`
+	RuntimeOptions        *rgb.RuntimeOptions      `json:"runtimeOptions"`
+	ScreenOffTimes        []string                 `json:"screenOffTimes"`
+	ScreenOnTimes         []string                 `json:"screenOnTimes"`
+	WebBoardWidth         int                      `json:"webBoardWidth"`
+	WebBoardHeight        int                      `json:"webBoardHeight"`
+	LaunchWebBoard        bool                     `json:"launchWebBoard"`
+	WebBoardUser          string                   `json:"webBoardUser"`
+	CombinedScroll        *atomic.Bool             `json:"combinedScroll"`
+	CombinedScrollDelay   string                   `json:"combinedScrollDelay"`
+	CombinedScrollPadding int                      `json:"combinedScrollPadding"`
+	PriorityInterval      string                   `json:"priorityInterval"`
+	Playlists             []*Playlist              `json:"playlists"`
+	DefaultPlaylist       string                   `json:"defaultPlaylist"`
+	Zones                 []*Zone                  `json:"zones"`
+	Transition            *Transition              `json:"transition"`
+	BoardTransitions      map[string]*Transition   `json:"boardTransitions"`
+	RecordingDir          string                   `json:"recordingDir"`
+	WatchdogTimeout       string                   `json:"watchdogTimeout"`
+	BrightnessSchedule    *BrightnessSchedule      `json:"brightnessSchedule"`
+	PowerLimit            *rgb.PowerLimit          `json:"powerLimit"`
+	MQTT                  *MQTTConfig              `json:"mqtt"`
+	Auth                  *twirphelpers.AuthConfig `json:"auth"`
+	TLS                   *TLSConfig               `json:"tls"`
 }
 
 type orderedBoard struct {
@@ -143,6 +179,46 @@ func (c *Config) Defaults() {
 	} else {
 		c.combinedScrollDelay = rgb.DefaultScrollDelay
 	}
+	if c.PriorityInterval != "" {
+		d, err := time.ParseDuration(c.PriorityInterval)
+		if err != nil {
+			c.priorityInterval = defaultPriorityInterval
+		} else {
+			c.priorityInterval = d
+		}
+	} else {
+		c.priorityInterval = defaultPriorityInterval
+	}
+	if c.WatchdogTimeout != "" {
+		d, err := time.ParseDuration(c.WatchdogTimeout)
+		if err != nil {
+			c.watchdogTimeout = defaultWatchdogTimeout
+		} else {
+			c.watchdogTimeout = d
+		}
+	} else {
+		c.watchdogTimeout = defaultWatchdogTimeout
+	}
+	for _, p := range c.Playlists {
+		p.SetDefaults()
+	}
+	if c.Transition != nil {
+		c.Transition.SetDefaults()
+	}
+	for _, t := range c.BoardTransitions {
+		if t != nil {
+			t.SetDefaults()
+		}
+	}
+	if c.MQTT != nil {
+		c.MQTT.SetDefaults()
+	}
+	if c.PowerLimit != nil {
+		c.PowerLimit.SetDefaults(c.HardwareConfig.ChainLength * c.HardwareConfig.Parallel)
+	}
+	if c.BrightnessSchedule != nil && c.BrightnessSchedule.Sun != nil {
+		c.BrightnessSchedule.Sun.SetDefaults(c.HardwareConfig.Brightness)
+	}
 }
 
 // New ...
@@ -168,6 +244,31 @@ func New(ctx context.Context, logger *zap.Logger, cfg *Config, canvases []board.
 		liveOnly:         atomic.NewBool(false),
 		scrollStatus:     make(chan float64),
 		scrollInProgress: atomic.NewBool(false),
+		activePlaylist:   atomic.NewString(""),
+		frames:           newCanvasFrames(),
+		recording:        atomic.NewBool(false),
+		health:           newHealthTracker(),
+		brightness:       atomic.NewInt32(int32(cfg.HardwareConfig.Brightness)),
+		current:          atomic.NewString(""),
+	}
+
+	if err := s.cfg.validatePlaylists(); err != nil {
+		return nil, err
+	}
+	if err := s.cfg.validateTransitions(); err != nil {
+		return nil, err
+	}
+	if err := s.cfg.validateBrightness(); err != nil {
+		return nil, err
+	}
+
+	var err error
+	s.auth, err = twirphelpers.NewAuth(s.cfg.Auth, s.log)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.cfg.TLS.Load(s.log); err != nil {
+		return nil, err
 	}
 
 	s.boardCtx, s.boardCancel = context.WithCancel(context.Background())
@@ -193,7 +294,34 @@ func New(ctx context.Context, logger *zap.Logger, cfg *Config, canvases []board.
 		s.log.Info("Registering board", zap.String("board", b.Name()))
 	}
 
-	c := cron.New()
+	if s.cfg.DefaultPlaylist != "" {
+		if err := s.SetPlaylist(s.cfg.DefaultPlaylist); err != nil {
+			return nil, err
+		}
+	}
+
+	c := simclock.NewCron()
+
+	for _, p := range s.cfg.Playlists {
+		name := p.Name
+		for _, start := range p.StartTimes {
+			s.log.Info("Playlist will be scheduled to start",
+				zap.String("playlist", name),
+				zap.String("start", start),
+			)
+			_, err := c.AddFunc(start, func() {
+				if err := s.SetPlaylist(name); err != nil {
+					s.log.Error("failed to set scheduled playlist",
+						zap.String("playlist", name),
+						zap.Error(err),
+					)
+				}
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to add cron for playlist %s: %w", name, err)
+			}
+		}
+	}
 
 	for _, off := range s.cfg.ScreenOffTimes {
 		s.log.Info("Screen will be scheduled to turn off", zap.String("turn off", off))
@@ -223,6 +351,9 @@ func New(ctx context.Context, logger *zap.Logger, cfg *Config, canvases []board.
 			return nil, fmt.Errorf("failed to add cron for screen on times: %w", err)
 		}
 	}
+	if err := s.scheduleBrightness(c); err != nil {
+		return nil, err
+	}
 	c.Start()
 
 	return s, nil
@@ -302,6 +433,8 @@ func (s *SportsMatrix) ScreenOff(ctx context.Context) error {
 	for _, canvas := range s.canvases {
 		_ = canvas.Clear()
 	}
+	s.clearZones()
+	s.frames.reset()
 
 	s.boardCtx, s.boardCancel = context.WithCancel(s.serveContext)
 
@@ -400,10 +533,19 @@ func (s *SportsMatrix) Serve(ctx context.Context) error {
 	s.boardCtx, s.boardCancel = context.WithCancel(ctx)
 	defer s.boardCancel()
 
-	if s.cfg.LaunchWebBoard {
+	if s.restoreState() {
 		s.startWebBoard(ctx)
 	}
 
+	go s.watchPriority(ctx)
+	go s.watchdog(ctx)
+	go s.serveBrightness(ctx)
+	s.startMQTT(ctx)
+
+	for _, z := range s.zones {
+		go s.serveZone(ctx, z)
+	}
+
 	if len(s.boards) < 1 {
 		return fmt.Errorf("no boards configured")
 	}
@@ -421,8 +563,8 @@ func (s *SportsMatrix) Serve(ctx context.Context) error {
 	setServingOnce := sync.Once{}
 
 	boardOrder := []string{}
-	for _, b := range s.boards {
-		boardOrder = append(boardOrder, b.Name())
+	for _, entry := range s.rotation(s.activePlaylist.Load()) {
+		boardOrder = append(boardOrder, entry.board.Name())
 
 		for _, inb := range s.betweenBoards {
 			boardOrder = append(boardOrder, inb.Name())
@@ -430,9 +572,24 @@ func (s *SportsMatrix) Serve(ctx context.Context) error {
 	}
 
 	s.log.Info("Board Render order",
+		zap.String("playlist", s.activePlaylist.Load()),
 		zap.Strings("order", boardOrder),
 	)
 
+	var prioritizers []string
+	for _, b := range s.boards {
+		if _, ok := b.(board.Prioritizer); ok {
+			prioritizers = append(prioritizers, b.Name())
+		}
+	}
+	s.log.Info("Boards that can take priority",
+		zap.Strings("boards", prioritizers),
+	)
+
+	if err := sdNotify("READY=1"); err != nil {
+		s.log.Error("failed to notify systemd", zap.Error(err))
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -441,6 +598,8 @@ func (s *SportsMatrix) Serve(ctx context.Context) error {
 		default:
 		}
 
+		s.health.beat()
+
 		if s.allDisabled() {
 			clearer.Do(func() {
 				for _, canvas := range s.canvases {
@@ -448,6 +607,7 @@ func (s *SportsMatrix) Serve(ctx context.Context) error {
 						s.log.Error("failed to clear matrix when all boards were disabled", zap.Error(err))
 					}
 				}
+				s.frames.reset()
 			})
 
 			continue
@@ -484,16 +644,27 @@ func (s *SportsMatrix) Serve(ctx context.Context) error {
 }
 
 func (s *SportsMatrix) serveLoop(ctx context.Context) {
+	playlist := s.activePlaylist.Load()
+
 BOARDS:
-	for _, b := range s.boards {
+	for _, entry := range s.rotation(playlist) {
 		select {
 		case <-ctx.Done():
 			return
 		default:
 		}
 
+		// Start over with the new rotation when the playlist changes
+		if s.activePlaylist.Load() != playlist {
+			return
+		}
+
+		s.servePriority(ctx)
+
+		b := entry.board
+
 		s.currentBoardCtx, s.currentBoardCancel = context.WithCancel(ctx)
-		if err := s.doBoard(s.currentBoardCtx, b); err != nil {
+		if err := s.doBoardDwell(s.currentBoardCtx, b, entry.dwell); err != nil {
 			s.currentBoardCancel()
 			continue BOARDS
 		}
@@ -533,11 +704,12 @@ func (s *SportsMatrix) doCombinedScroll(ctx context.Context) error {
 	scrollCtx, cancel := context.WithCancel(ctx)
 
 	boards := []board.Board{}
+	rotationBoards := s.rotationBoards()
 
 	canceler := func() {
 		cancel()
 	}
-	for _, board := range s.boards {
+	for _, board := range rotationBoards {
 		if board.Enabled() {
 			board.SetStateChangeNotifier(canceler)
 			boards = append(boards, board)
@@ -571,7 +743,7 @@ CANVASES:
 		}
 
 		ch := make(chan *orderedBoard, len(boards))
-		s.prepOrderedBoards(ctx, s.boards, base.Matrix, ch)
+		s.prepOrderedBoards(ctx, rotationBoards, base.Matrix, ch)
 
 		betweenCh := make(chan *orderedBoard, len(boards))
 		s.prepOrderedBoards(ctx, s.betweenBoards, base.Matrix, betweenCh)
@@ -712,6 +884,8 @@ func (s *SportsMatrix) doBoard(ctx context.Context, b board.Board) error {
 
 	s.currentJump = ""
 
+	s.health.beat()
+
 	s.log.Debug("Processing board", zap.String("board", b.Name()))
 
 	if !b.Enabled() {
@@ -719,12 +893,30 @@ func (s *SportsMatrix) doBoard(ctx context.Context, b board.Board) error {
 		return nil
 	}
 
+	currentBoard.Set(1, b.Name())
+	defer currentBoard.Set(0, b.Name())
+
+	s.current.Store(b.Name())
+	if s.mqtt != nil {
+		s.mqtt.stateChanged()
+	}
+
+	return s.renderBoard(ctx, b, s.canvases)
+}
+
+// renderBoard renders a board to each of the canvases that suit its scroll mode
+func (s *SportsMatrix) renderBoard(ctx context.Context, b board.Board, canvases []board.Canvas) error {
 	var wg sync.WaitGroup
 
 	var boardErr error
 
+	start := time.Now()
+	defer func() {
+		boardDisplaySeconds.Add(time.Since(start).Seconds(), b.Name())
+	}()
+
 CANVASES:
-	for _, canvas := range s.canvases {
+	for _, canvas := range timedCanvases(b, s.transitionCanvases(b, canvases)) {
 		if !canvas.Enabled() {
 			// s.log.Warn("canvas is disabled, skipping", zap.String("canvas", canvas.Name()))
 			continue CANVASES
@@ -740,8 +932,11 @@ CANVASES:
 		go func(canvas board.Canvas) {
 			defer wg.Done()
 			s.log.Debug("rendering board", zap.String("board", b.Name()))
-			if err := b.Render(s.currentBoardCtx, canvas); err != nil {
+			if err := b.Render(ctx, canvas); err != nil {
 				boardErr = err
+				if !errors.Is(err, context.Canceled) {
+					boardErrors.Inc(b.Name())
+				}
 				s.log.Error("board render returned error",
 					zap.Error(err),
 				)
@@ -764,6 +959,8 @@ CANVASES:
 	}
 	s.log.Debug("done waiting for canvases")
 
+	s.health.rendered(b.Name(), boardErr)
+
 	return boardErr
 }
 
@@ -771,6 +968,9 @@ CANVASES:
 func (s *SportsMatrix) Close() {
 	s.close <- struct{}{}
 	s.server.Close()
+	if s.redirectServer != nil {
+		s.redirectServer.Close()
+	}
 }
 
 func (s *SportsMatrix) allDisabled() bool {
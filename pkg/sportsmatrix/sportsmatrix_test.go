@@ -2,7 +2,15 @@ package sportsmatrix
 
 import (
 	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"net"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -14,9 +22,11 @@ import (
 	"go.uber.org/zap/zaptest"
 
 	"github.com/robbydyer/sports/pkg/board"
+	rgb "github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
 )
 
 type TestBoard struct {
+	name        string
 	log         *zap.Logger
 	enabled     *atomic.Bool
 	hasRendered *atomic.Bool
@@ -40,6 +50,9 @@ func (b *TestBoard) Disable() bool {
 }
 
 func (b *TestBoard) Name() string {
+	if b.name != "" {
+		return b.name
+	}
 	return "Blank Board"
 }
 
@@ -258,3 +271,463 @@ func TestScreenSwitch(t *testing.T) {
 		require.NotNil(t, nil, "timed out waiting for serve to close")
 	}
 }
+
+type PriorityTestBoard struct {
+	*TestBoard
+	priority *atomic.Bool
+}
+
+func (b *PriorityTestBoard) Name() string {
+	return "Priority Board"
+}
+
+func (b *PriorityTestBoard) HasPriority(ctx context.Context) bool {
+	return b.priority.Load()
+}
+
+func (b *PriorityTestBoard) Render(ctx context.Context, canvases board.Canvas) error {
+	b.hasRendered.Store(true)
+	select {
+	case <-ctx.Done():
+	case <-time.After(100 * time.Millisecond):
+	}
+	return nil
+}
+
+type BlockingTestBoard struct {
+	*TestBoard
+}
+
+func (b *BlockingTestBoard) Render(ctx context.Context, canvases board.Canvas) error {
+	b.hasRendered.Store(true)
+	<-ctx.Done()
+	return nil
+}
+
+func TestPriorityPreempt(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := zaptest.NewLogger(t, zaptest.Level(zapcore.ErrorLevel))
+	cfg := &Config{
+		ServeWebUI:       false,
+		HTTPListenPort:   8080,
+		WebBoardWidth:    1,
+		PriorityInterval: "100ms",
+	}
+	cfg.Defaults()
+
+	canvas := board.NewBlankCanvas(1, 1, logger)
+	canvas.Enable()
+
+	blocking := &BlockingTestBoard{
+		TestBoard: &TestBoard{
+			log:         logger,
+			enabled:     atomic.NewBool(true),
+			hasRendered: atomic.NewBool(false),
+		},
+	}
+	priority := &PriorityTestBoard{
+		TestBoard: &TestBoard{
+			log:         logger,
+			enabled:     atomic.NewBool(true),
+			hasRendered: atomic.NewBool(false),
+		},
+		priority: atomic.NewBool(false),
+	}
+
+	s, err := New(ctx, logger, cfg, []board.Canvas{canvas}, blocking, priority)
+	require.NoError(t, err)
+	defer s.Close()
+
+	serveDone := make(chan struct{})
+	go func() {
+		defer close(serveDone)
+		_ = s.Serve(ctx)
+	}()
+
+	waitFor := func(cond func() bool, msg string) {
+		timeout := time.After(10 * time.Second)
+		ticker := time.NewTicker(50 * time.Millisecond)
+		defer ticker.Stop()
+		for !cond() {
+			select {
+			case <-timeout:
+				require.FailNow(t, msg)
+			case <-ticker.C:
+			}
+		}
+	}
+
+	waitFor(blocking.HasRendered, "timed out waiting for blocking board to render")
+
+	// The blocking board never finishes on its own, so the priority board can only
+	// render if it preempts
+	priority.priority.Store(true)
+	waitFor(priority.HasRendered, "timed out waiting for priority board to preempt")
+
+	blocking.hasRendered.Store(false)
+	priority.priority.Store(false)
+	waitFor(blocking.HasRendered, "timed out waiting to return to normal rotation")
+	waitFor(func() bool { return s.getPriorityBoard() == nil }, "priority board was not cleared")
+
+	cancel()
+
+	select {
+	case <-serveDone:
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "timed out waiting for serve to close")
+	}
+}
+
+func TestPlaylistRotation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := zaptest.NewLogger(t, zaptest.Level(zapcore.ErrorLevel))
+	cfg := &Config{
+		ServeWebUI:     false,
+		HTTPListenPort: 8080,
+		WebBoardWidth:  1,
+		Playlists: []*Playlist{
+			{
+				Name: "gameday",
+				Boards: []*PlaylistBoard{
+					{
+						Board:  "Scores",
+						Weight: 2,
+						Dwell:  "2m",
+					},
+					{
+						Board: "Weather",
+					},
+					{
+						Board: "Nope",
+					},
+				},
+			},
+		},
+	}
+	cfg.Defaults()
+
+	canvas := board.NewBlankCanvas(1, 1, logger)
+
+	var boards []board.Board
+	for _, name := range []string{"Scores", "Weather", "Clock"} {
+		boards = append(boards, &TestBoard{
+			name:        name,
+			log:         logger,
+			enabled:     atomic.NewBool(true),
+			hasRendered: atomic.NewBool(false),
+		})
+	}
+
+	s, err := New(ctx, logger, cfg, []board.Canvas{canvas}, boards...)
+	require.NoError(t, err)
+
+	names := func(entries []*rotationEntry) []string {
+		var n []string
+		for _, e := range entries {
+			n = append(n, e.board.Name())
+		}
+		return n
+	}
+
+	require.Equal(t, []string{"Scores", "Weather", "Clock"}, names(s.rotation("")))
+
+	require.Error(t, s.SetPlaylist("weekday"))
+	require.NoError(t, s.SetPlaylist("GameDay"))
+	require.Equal(t, "gameday", s.ActivePlaylist())
+
+	rotation := s.rotation(s.ActivePlaylist())
+	require.Equal(t, []string{"Scores", "Weather", "Scores"}, names(rotation))
+	require.Equal(t, 2*time.Minute, rotation[0].dwell)
+	require.Equal(t, time.Duration(0), rotation[1].dwell)
+
+	var unique []string
+	for _, b := range s.rotationBoards() {
+		unique = append(unique, b.Name())
+	}
+	require.Equal(t, []string{"Scores", "Weather"}, unique)
+
+	cfg.Playlists[0].Boards[0].Dwell = "2 minutes"
+	_, err = New(ctx, logger, cfg, []board.Canvas{canvas}, boards...)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `playlist gameday: invalid dwell "2 minutes" for board Scores`)
+
+	require.NoError(t, s.SetPlaylist(""))
+	require.Equal(t, "", s.ActivePlaylist())
+}
+
+func TestTransitionEffects(t *testing.T) {
+	bounds := image.Rect(0, 0, 4, 2)
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+
+	from := image.NewRGBA(bounds)
+	draw.Draw(from, bounds, &image.Uniform{red}, image.Point{}, draw.Src)
+	to := image.NewRGBA(bounds)
+	draw.Draw(to, bounds, &image.Uniform{blue}, image.Point{}, draw.Src)
+
+	tests := []struct {
+		effect string
+		check  func(dst *image.RGBA)
+	}{
+		{
+			effect: TransitionWipeRight,
+			check: func(dst *image.RGBA) {
+				require.Equal(t, blue, dst.RGBAAt(0, 0))
+				require.Equal(t, red, dst.RGBAAt(3, 0))
+			},
+		},
+		{
+			effect: TransitionWipeLeft,
+			check: func(dst *image.RGBA) {
+				require.Equal(t, red, dst.RGBAAt(0, 0))
+				require.Equal(t, blue, dst.RGBAAt(3, 0))
+			},
+		},
+		{
+			effect: TransitionSlideUp,
+			check: func(dst *image.RGBA) {
+				require.Equal(t, red, dst.RGBAAt(0, 0))
+				require.Equal(t, blue, dst.RGBAAt(0, 1))
+			},
+		},
+		{
+			effect: TransitionFade,
+			check: func(dst *image.RGBA) {
+				require.Equal(t, color.RGBA{A: 255}, dst.RGBAAt(0, 0))
+			},
+		},
+		{
+			effect: TransitionDissolve,
+			check: func(dst *image.RGBA) {
+				switched := 0
+				for x := 0; x < 4; x++ {
+					for y := 0; y < 2; y++ {
+						if dst.RGBAAt(x, y) == blue {
+							switched++
+						}
+					}
+				}
+				require.Equal(t, 4, switched)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.effect, func(t *testing.T) {
+			tr := &Transition{Effect: test.effect}
+			tr.SetDefaults()
+			effect, err := tr.effect()
+			require.NoError(t, err)
+
+			dst := image.NewRGBA(bounds)
+			effect(dst, from, to, 0.5)
+			test.check(dst)
+		})
+	}
+
+	_, err := (&Transition{Effect: "spin"}).effect()
+	require.Error(t, err)
+
+	cfg := &Config{
+		BoardTransitions: map[string]*Transition{
+			"Clock": {Effect: TransitionFade, Duration: "fast"},
+		},
+	}
+	err = cfg.validateTransitions()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `board Clock: invalid transition duration "fast"`)
+}
+
+func TestTransitionCanvas(t *testing.T) {
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+
+	matrix := rgb.NewRecordingMatrix(rgb.NewMemoryMatrix(4, 2))
+	canvas := rgb.NewCanvas(matrix)
+	frames := newCanvasFrames()
+
+	tr := &Transition{Effect: TransitionWipeRight, Duration: "90ms"}
+	tr.SetDefaults()
+
+	done, err := matrix.StartRecording(context.Background(), 0, 0)
+	require.NoError(t, err)
+
+	// The first board renders, then clears its canvas when it's done
+	first := &transitionCanvas{Canvas: canvas, transition: tr, frames: frames}
+	draw.Draw(first, first.Bounds(), &image.Uniform{red}, image.Point{}, draw.Src)
+	require.NoError(t, first.Render(context.Background()))
+	require.NoError(t, first.Clear())
+
+	next := &transitionCanvas{Canvas: canvas, transition: tr, frames: frames}
+	draw.Draw(next, next.Bounds(), &image.Uniform{blue}, image.Point{}, draw.Src)
+	require.NoError(t, next.Render(context.Background()))
+
+	require.True(t, matrix.StopRecording())
+	recorded := <-done
+
+	// The transition starts from the frame that was on the matrix, without blanking it first
+	require.Greater(t, len(recorded), 2)
+	require.Equal(t, red, recorded[0].Image.RGBAAt(3, 1))
+	for _, f := range recorded[1 : len(recorded)-1] {
+		require.Equal(t, blue, f.Image.RGBAAt(0, 0))
+		require.Equal(t, red, f.Image.RGBAAt(3, 1))
+	}
+	require.Equal(t, blue, recorded[len(recorded)-1].Image.RGBAAt(3, 1))
+}
+
+type DiagnosingTestBoard struct {
+	TestBoard
+	diagnostics board.DiagnosticsTracker
+}
+
+func (b *DiagnosingTestBoard) Diagnostics() *board.Diagnostics {
+	return b.diagnostics.Diagnostics()
+}
+
+func TestHealth(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := zaptest.NewLogger(t, zaptest.Level(zapcore.ErrorLevel))
+	cfg := &Config{
+		ServeWebUI:     false,
+		HTTPListenPort: 8080,
+		WebBoardWidth:  1,
+	}
+
+	canvas := board.NewBlankCanvas(1, 1, logger)
+
+	scores := &DiagnosingTestBoard{
+		TestBoard: TestBoard{
+			name:        "Scores",
+			log:         logger,
+			enabled:     atomic.NewBool(true),
+			hasRendered: atomic.NewBool(false),
+		},
+	}
+	clock := &TestBoard{
+		name:        "Clock",
+		log:         logger,
+		enabled:     atomic.NewBool(false),
+		hasRendered: atomic.NewBool(false),
+	}
+
+	s, err := New(ctx, logger, cfg, []board.Canvas{canvas}, scores, clock)
+	require.NoError(t, err)
+
+	scores.diagnostics.Skipped(board.SkipNoGames)
+	s.health.rendered("Scores", fmt.Errorf("no games scheduled"))
+	s.health.rendered("Clock", context.Canceled)
+
+	health := s.Health()
+	require.Len(t, health, 2)
+	require.Equal(t, board.SkipNoGames, health[0].SkipReason)
+	require.Equal(t, "no games scheduled", health[0].LastError)
+	require.True(t, health[0].LastRender.IsZero())
+	require.Equal(t, board.SkipDisabled, health[1].SkipReason)
+	require.Empty(t, health[1].LastError)
+
+	scores.diagnostics.DataLoaded()
+	s.health.rendered("Scores", nil)
+	health = s.Health()
+	require.Empty(t, health[0].SkipReason)
+	require.False(t, health[0].LastRender.IsZero())
+	require.False(t, health[0].DataUpdated.IsZero())
+
+	// Boards turned off by their schedule report off hours rather than disabled
+	scores.diagnostics.SetOffHours(true)
+	require.Empty(t, s.Health()[0].SkipReason)
+	scores.Disable()
+	require.Equal(t, board.SkipOffHours, s.Health()[0].SkipReason)
+}
+
+func TestSdNotify(t *testing.T) {
+	sock := filepath.Join(t.TempDir(), "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: sock, Net: "unixgram"})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	setenv := func(key string, val string) {
+		require.NoError(t, os.Setenv(key, val))
+	}
+	defer func() {
+		for _, key := range []string{"NOTIFY_SOCKET", "WATCHDOG_USEC", "WATCHDOG_PID"} {
+			_ = os.Unsetenv(key)
+		}
+	}()
+
+	setenv("NOTIFY_SOCKET", sock)
+	require.NoError(t, sdNotify("WATCHDOG=1"))
+
+	buf := make([]byte, 64)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "WATCHDOG=1", string(buf[:n]))
+
+	setenv("WATCHDOG_USEC", "30000000")
+	setenv("WATCHDOG_PID", "1")
+	require.Equal(t, time.Duration(0), watchdogInterval())
+	setenv("WATCHDOG_PID", strconv.Itoa(os.Getpid()))
+	require.Equal(t, 15*time.Second, watchdogInterval())
+}
+
+func TestBrightnessSchedule(t *testing.T) {
+	noon := time.Date(2021, time.June, 21, 16, 57, 0, 0, time.UTC)
+	midnight := time.Date(2021, time.June, 21, 4, 57, 0, 0, time.UTC)
+
+	// New York City
+	require.InDelta(t, 72.7, sunElevation(noon, 40.7128, -74.006), 0.5)
+	require.InDelta(t, -25.8, sunElevation(midnight, 40.7128, -74.006), 0.5)
+
+	sun := &SunBrightness{
+		Latitude:  40.7128,
+		Longitude: -74.006,
+	}
+	sun.SetDefaults(60)
+	require.Equal(t, 60, sun.brightness(noon))
+	require.Equal(t, defaultNightBrightness, sun.brightness(midnight))
+
+	// Sunset is at about 00:31 UTC, during the fade
+	dusk := sun.brightness(time.Date(2021, time.June, 22, 0, 31, 0, 0, time.UTC))
+	require.Greater(t, dusk, defaultNightBrightness)
+	require.Less(t, dusk, 60)
+
+	levels := []*BrightnessLevel{
+		{
+			Time:       "0 22 * * *",
+			Brightness: 20,
+		},
+		{
+			Time:       "30 7 * * *",
+			Brightness: 60,
+		},
+	}
+	level, ok := scheduledBrightness(levels, time.Date(2021, time.June, 21, 23, 0, 0, 0, time.Local))
+	require.True(t, ok)
+	require.Equal(t, 20, level)
+	level, ok = scheduledBrightness(levels, time.Date(2021, time.June, 21, 7, 0, 0, 0, time.Local))
+	require.True(t, ok)
+	require.Equal(t, 20, level)
+	level, ok = scheduledBrightness(levels, time.Date(2021, time.June, 21, 12, 0, 0, 0, time.Local))
+	require.True(t, ok)
+	require.Equal(t, 60, level)
+
+	cfg := &Config{
+		BrightnessSchedule: &BrightnessSchedule{
+			Levels: levels,
+			Sun:    sun,
+		},
+	}
+	cfg.Defaults()
+	require.Error(t, cfg.validateBrightness())
+	cfg.BrightnessSchedule.Sun = nil
+	require.NoError(t, cfg.validateBrightness())
+	levels[0].Brightness = 101
+	require.Error(t, cfg.validateBrightness())
+}
@@ -0,0 +1,94 @@
+package sportsmatrix
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/robbydyer/sports/pkg/board"
+)
+
+const (
+	screenOnState   = "screenOn"
+	webBoardOnState = "webBoardOn"
+)
+
+// StateRecorder persists changes made through the API so that they survive a restart
+type StateRecorder interface {
+	board.StateRecorder
+	// Store persists a value that isn't part of the config
+	Store(key string, val bool)
+	// Load returns a persisted value and whether it was set
+	Load(key string) (bool, bool)
+	// Reset clears all persisted values
+	Reset() error
+}
+
+// SetStateRecorder sets the StateRecorder used to persist API changes. This must
+// be called before Serve
+func (s *SportsMatrix) SetStateRecorder(r StateRecorder) {
+	s.stateRecorder = r
+}
+
+// ResetState clears any persisted API changes
+func (s *SportsMatrix) ResetState() error {
+	if s.stateRecorder == nil {
+		return nil
+	}
+
+	s.log.Warn("resetting persisted state")
+
+	return s.stateRecorder.Reset()
+}
+
+// recordState wraps API handlers so that the toggles they change are persisted
+func (s *SportsMatrix) recordState(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if s.stateRecorder == nil {
+			h.ServeHTTP(w, req)
+			return
+		}
+
+		h.ServeHTTP(w, req.WithContext(board.WithStateRecorder(req.Context(), s.stateRecorder)))
+	})
+}
+
+// storeState persists the screen or web board state after an API request changed it
+func (s *SportsMatrix) storeState(key string, on bool) {
+	if s.stateRecorder == nil {
+		return
+	}
+
+	s.stateRecorder.Store(key, on)
+}
+
+// recordEnabled persists the enabled state of boards that an API request enabled or disabled
+func recordEnabled(ctx context.Context, boards ...board.Board) {
+	for _, b := range boards {
+		if t, ok := b.(board.Toggler); ok {
+			board.RecordToggles(ctx, t.EnabledToggle())
+		}
+	}
+}
+
+// restoreState sets the screen and web board to their persisted state. It returns
+// whether the web board should be launched
+func (s *SportsMatrix) restoreState() bool {
+	launchWebBoard := s.cfg.LaunchWebBoard
+
+	if s.stateRecorder == nil {
+		return launchWebBoard
+	}
+
+	if on, ok := s.stateRecorder.Load(webBoardOnState); ok {
+		launchWebBoard = on
+	}
+
+	if on, ok := s.stateRecorder.Load(screenOnState); ok && !on {
+		s.log.Warn("restoring screen to off")
+		s.screenIsOn.Store(false)
+		s.webBoardWasOn.Store(launchWebBoard)
+		return false
+	}
+
+	return launchWebBoard
+}
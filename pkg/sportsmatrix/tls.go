@@ -0,0 +1,176 @@
+package sportsmatrix
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var selfSignedValidity = 10 * 365 * 24 * time.Hour
+
+// TLSConfig serves the HTTP server over HTTPS
+type TLSConfig struct {
+	cert     *tls.Certificate
+	CertFile string `json:"certFile"`
+	KeyFile  string `json:"keyFile"`
+	// SelfSigned generates a self-signed cert/key pair if the files don't exist
+	SelfSigned bool `json:"selfSigned"`
+	// Hosts are the names and IPs the self-signed cert is valid for. Defaults to the hostname,
+	// localhost and every local IP
+	Hosts []string `json:"hosts"`
+	// RedirectPort listens for plain HTTP and redirects to HTTPS. 0 disables it
+	RedirectPort int `json:"redirectPort"`
+}
+
+// Load reads the cert/key pair, generating it first if it's missing and SelfSigned is set.
+// This should be called before dropping root privileges, so the key can be read
+func (t *TLSConfig) Load(logger *zap.Logger) error {
+	if t == nil || t.cert != nil {
+		return nil
+	}
+	if t.CertFile == "" || t.KeyFile == "" {
+		return fmt.Errorf("tls config requires certFile and keyFile")
+	}
+
+	if t.SelfSigned && !fileExists(t.CertFile) && !fileExists(t.KeyFile) {
+		logger.Info("generating self-signed certificate",
+			zap.String("cert", t.CertFile),
+			zap.String("key", t.KeyFile),
+		)
+		if err := t.generate(); err != nil {
+			return fmt.Errorf("failed to generate self-signed certificate: %w", err)
+		}
+	}
+
+	cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	t.cert = &cert
+
+	return nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return !errors.Is(err, os.ErrNotExist)
+}
+
+// generate writes a new self-signed cert/key pair
+func (t *TLSConfig) generate() error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return err
+	}
+
+	hosts := t.Hosts
+	if len(hosts) < 1 {
+		hosts = defaultCertHosts()
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"sportsmatrix"}, CommonName: hosts[0]},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(selfSignedValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range []string{t.CertFile, t.KeyFile} {
+		if err := os.MkdirAll(filepath.Dir(f), 0o755); err != nil {
+			return err
+		}
+	}
+	if err := os.WriteFile(t.KeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600); err != nil {
+		return err
+	}
+
+	return os.WriteFile(t.CertFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644)
+}
+
+func defaultCertHosts() []string {
+	hosts := []string{}
+	if h, err := os.Hostname(); err == nil && h != "" {
+		hosts = append(hosts, h, h+".local")
+	}
+	hosts = append(hosts, "localhost")
+
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return hosts
+	}
+	for _, a := range addrs {
+		if n, ok := a.(*net.IPNet); ok {
+			hosts = append(hosts, n.IP.String())
+		}
+	}
+
+	return hosts
+}
+
+// tlsConfig returns the server's TLS config, or nil when TLS isn't enabled
+func (s *SportsMatrix) tlsConfig() *tls.Config {
+	if s.cfg.TLS == nil || s.cfg.TLS.cert == nil {
+		return nil
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{*s.cfg.TLS.cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+}
+
+// redirectHandler redirects plain HTTP requests to the HTTPS server. 308 is used so that RPC
+// clients resend their POSTs
+func (s *SportsMatrix) redirectHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		host := req.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if s.cfg.HTTPListenPort != 443 {
+			host = net.JoinHostPort(host, strconv.Itoa(s.cfg.HTTPListenPort))
+		}
+
+		http.Redirect(w, req, "https://"+host+req.URL.RequestURI(), http.StatusPermanentRedirect)
+	})
+}
@@ -0,0 +1,42 @@
+package sportsmatrix
+
+import (
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestTLS(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &TLSConfig{
+		CertFile:   filepath.Join(dir, "sportsmatrix.crt"),
+		KeyFile:    filepath.Join(dir, "sportsmatrix.key"),
+		SelfSigned: true,
+		Hosts:      []string{"mypi.local", "192.168.1.10"},
+	}
+	require.NoError(t, cfg.Load(zap.NewNop()))
+
+	leaf, err := x509.ParseCertificate(cfg.cert.Certificate[0])
+	require.NoError(t, err)
+	require.NoError(t, leaf.VerifyHostname("mypi.local"))
+	require.NoError(t, leaf.VerifyHostname("192.168.1.10"))
+
+	// The persisted pair is reused
+	again := &TLSConfig{CertFile: cfg.CertFile, KeyFile: cfg.KeyFile, SelfSigned: true}
+	require.NoError(t, again.Load(zap.NewNop()))
+	require.Equal(t, cfg.cert.Certificate, again.cert.Certificate)
+
+	missing := &TLSConfig{CertFile: filepath.Join(dir, "nope.crt"), KeyFile: filepath.Join(dir, "nope.key")}
+	require.Error(t, missing.Load(zap.NewNop()))
+
+	s := &SportsMatrix{cfg: &Config{HTTPListenPort: 8443, TLS: cfg}}
+	w := httptest.NewRecorder()
+	s.redirectHandler().ServeHTTP(w, httptest.NewRequest(http.MethodPost, "http://mypi.local/matrix.v1.Sportsmatrix/Jump?x=1", nil))
+	require.Equal(t, http.StatusPermanentRedirect, w.Code)
+	require.Equal(t, "https://mypi.local:8443/matrix.v1.Sportsmatrix/Jump?x=1", w.Header().Get("Location"))
+}
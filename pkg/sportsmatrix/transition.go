@@ -0,0 +1,307 @@
+package sportsmatrix
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/robbydyer/sports/pkg/board"
+)
+
+var (
+	defaultTransitionDuration = 500 * time.Millisecond
+	transitionFrameInterval   = 30 * time.Millisecond
+)
+
+// Transition effects
+const (
+	TransitionNone      = "none"
+	TransitionFade      = "fade"
+	TransitionWipeLeft  = "wipeLeft"
+	TransitionWipeRight = "wipeRight"
+	TransitionSlideUp   = "slideUp"
+	TransitionDissolve  = "dissolve"
+)
+
+// Transition is the effect shown between the last frame of one board and the first
+// frame of the next
+type Transition struct {
+	duration time.Duration
+	Effect   string `json:"effect"`
+	Duration string `json:"duration"`
+}
+
+// transitionFunc draws the frame at the given progress, from 0 to 1, of a transition
+type transitionFunc func(dst draw.Image, from *image.RGBA, to *image.RGBA, progress float64)
+
+type transitionCanvas struct {
+	board.Canvas
+	transition *Transition
+	frames     *canvasFrames
+	started    bool
+}
+
+// canvasFrames tracks the last frame rendered to each canvas
+type canvasFrames struct {
+	last map[board.Canvas]*image.RGBA
+	sync.Mutex
+}
+
+// SetDefaults sets some sane defaults for the transition
+func (t *Transition) SetDefaults() {
+	if t.Effect == "" {
+		t.Effect = TransitionNone
+	}
+	t.duration = defaultTransitionDuration
+	if t.Duration != "" {
+		d, err := time.ParseDuration(t.Duration)
+		if err == nil {
+			t.duration = d
+		}
+	}
+}
+
+func (t *Transition) effect() (transitionFunc, error) {
+	switch strings.ToLower(t.Effect) {
+	case "", strings.ToLower(TransitionNone):
+		return nil, nil
+	case strings.ToLower(TransitionFade):
+		return fadeThroughBlack, nil
+	case strings.ToLower(TransitionWipeLeft):
+		return wipeLeft, nil
+	case strings.ToLower(TransitionWipeRight):
+		return wipeRight, nil
+	case strings.ToLower(TransitionSlideUp):
+		return slideUp, nil
+	case strings.ToLower(TransitionDissolve):
+		return newDissolve(), nil
+	}
+
+	return nil, fmt.Errorf("unknown transition effect '%s'", t.Effect)
+}
+
+func (t *Transition) validate() error {
+	if _, err := t.effect(); err != nil {
+		return err
+	}
+	if t.Duration != "" {
+		if _, err := time.ParseDuration(t.Duration); err != nil {
+			return fmt.Errorf("invalid transition duration %q: %w", t.Duration, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Config) validateTransitions() error {
+	if c.Transition != nil {
+		if err := c.Transition.validate(); err != nil {
+			return err
+		}
+	}
+	for name, t := range c.BoardTransitions {
+		if t == nil {
+			continue
+		}
+		if err := t.validate(); err != nil {
+			return fmt.Errorf("board %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// boardTransition returns the transition to use when switching to the given board
+func (s *SportsMatrix) boardTransition(b board.Board) *Transition {
+	for name, t := range s.cfg.BoardTransitions {
+		if t != nil && strings.EqualFold(name, b.Name()) {
+			return t
+		}
+	}
+
+	return s.cfg.Transition
+}
+
+// transitionCanvases wraps the canvases that a board renders to so that the first frame of
+// the board is transitioned to from the last frame of the previous board
+func (s *SportsMatrix) transitionCanvases(b board.Board, canvases []board.Canvas) []board.Canvas {
+	if s.cfg.Transition == nil && len(s.cfg.BoardTransitions) < 1 {
+		return canvases
+	}
+
+	t := s.boardTransition(b)
+
+	wrapped := make([]board.Canvas, 0, len(canvases))
+	for _, canvas := range canvases {
+		// Scroll canvases are animated by the boards themselves
+		if canvas.Scrollable() {
+			wrapped = append(wrapped, canvas)
+			continue
+		}
+		wrapped = append(wrapped, &transitionCanvas{
+			Canvas:     canvas,
+			transition: t,
+			frames:     s.frames,
+		})
+	}
+
+	return wrapped
+}
+
+func newCanvasFrames() *canvasFrames {
+	return &canvasFrames{
+		last: make(map[board.Canvas]*image.RGBA),
+	}
+}
+
+func (f *canvasFrames) get(canvas board.Canvas) *image.RGBA {
+	f.Lock()
+	defer f.Unlock()
+	return f.last[canvas]
+}
+
+func (f *canvasFrames) set(canvas board.Canvas, img *image.RGBA) {
+	f.Lock()
+	defer f.Unlock()
+	f.last[canvas] = img
+}
+
+func (f *canvasFrames) reset() {
+	f.Lock()
+	defer f.Unlock()
+	f.last = make(map[board.Canvas]*image.RGBA)
+}
+
+// Render runs the transition before the first frame of a board is rendered
+func (c *transitionCanvas) Render(ctx context.Context) error {
+	frame := image.NewRGBA(c.Canvas.Bounds())
+	draw.Draw(frame, frame.Bounds(), c.Canvas, frame.Bounds().Min, draw.Src)
+
+	last := c.frames.get(c.Canvas)
+	c.frames.set(c.Canvas, frame)
+
+	if !c.started && last != nil && c.transition != nil && last.Bounds() == frame.Bounds() {
+		if err := c.runTransition(ctx, last, frame); err != nil {
+			return err
+		}
+		draw.Draw(c.Canvas, frame.Bounds(), frame, frame.Bounds().Min, draw.Src)
+	}
+	c.started = true
+
+	return c.Canvas.Render(ctx)
+}
+
+// Clear clears the canvas without showing it. Boards clear their canvas when they finish, but the
+// next board transitions from the last frame that was shown, so that frame is left on the matrix
+func (c *transitionCanvas) Clear() error {
+	draw.Draw(c.Canvas, c.Canvas.Bounds(), &image.Uniform{color.Black}, image.Point{}, draw.Src)
+	return nil
+}
+
+func (c *transitionCanvas) runTransition(ctx context.Context, from *image.RGBA, to *image.RGBA) error {
+	effect, err := c.transition.effect()
+	if err != nil || effect == nil {
+		return err
+	}
+
+	steps := int(c.transition.duration / transitionFrameInterval)
+
+	ticker := time.NewTicker(transitionFrameInterval)
+	defer ticker.Stop()
+
+	for i := 1; i < steps; i++ {
+		effect(c.Canvas, from, to, float64(i)/float64(steps))
+		if err := c.Canvas.Render(ctx); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return context.Canceled
+		case <-ticker.C:
+		}
+	}
+
+	return nil
+}
+
+func fadeThroughBlack(dst draw.Image, from *image.RGBA, to *image.RGBA, progress float64) {
+	src := from
+	scale := 1 - (2 * progress)
+	if progress >= 0.5 {
+		src = to
+		scale = (2 * progress) - 1
+	}
+
+	bounds := src.Bounds()
+	for x := bounds.Min.X; x < bounds.Max.X; x++ {
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			c := src.RGBAAt(x, y)
+			dst.Set(x, y, color.RGBA{
+				R: uint8(float64(c.R) * scale),
+				G: uint8(float64(c.G) * scale),
+				B: uint8(float64(c.B) * scale),
+				A: c.A,
+			})
+		}
+	}
+}
+
+// wipeLeft reveals the next frame from the right edge towards the left
+func wipeLeft(dst draw.Image, from *image.RGBA, to *image.RGBA, progress float64) {
+	bounds := from.Bounds()
+	edge := bounds.Max.X - int(float64(bounds.Dx())*progress)
+
+	draw.Draw(dst, image.Rect(bounds.Min.X, bounds.Min.Y, edge, bounds.Max.Y), from, bounds.Min, draw.Src)
+	draw.Draw(dst, image.Rect(edge, bounds.Min.Y, bounds.Max.X, bounds.Max.Y), to, image.Pt(edge, bounds.Min.Y), draw.Src)
+}
+
+// wipeRight reveals the next frame from the left edge towards the right
+func wipeRight(dst draw.Image, from *image.RGBA, to *image.RGBA, progress float64) {
+	bounds := from.Bounds()
+	edge := bounds.Min.X + int(float64(bounds.Dx())*progress)
+
+	draw.Draw(dst, image.Rect(bounds.Min.X, bounds.Min.Y, edge, bounds.Max.Y), to, bounds.Min, draw.Src)
+	draw.Draw(dst, image.Rect(edge, bounds.Min.Y, bounds.Max.X, bounds.Max.Y), from, image.Pt(edge, bounds.Min.Y), draw.Src)
+}
+
+// slideUp pushes the last frame up and out as the next frame slides in from the bottom
+func slideUp(dst draw.Image, from *image.RGBA, to *image.RGBA, progress float64) {
+	bounds := from.Bounds()
+	offset := int(float64(bounds.Dy()) * progress)
+	edge := bounds.Max.Y - offset
+
+	draw.Draw(dst, image.Rect(bounds.Min.X, bounds.Min.Y, bounds.Max.X, edge), from, image.Pt(bounds.Min.X, bounds.Min.Y+offset), draw.Src)
+	draw.Draw(dst, image.Rect(bounds.Min.X, edge, bounds.Max.X, bounds.Max.Y), to, bounds.Min, draw.Src)
+}
+
+// newDissolve returns a transitionFunc that switches pixels to the next frame in a random order
+func newDissolve() transitionFunc {
+	var order []int
+
+	return func(dst draw.Image, from *image.RGBA, to *image.RGBA, progress float64) {
+		bounds := from.Bounds()
+		w := bounds.Dx()
+		total := w * bounds.Dy()
+		if len(order) != total {
+			order = rand.Perm(total)
+		}
+
+		switched := int(float64(total) * progress)
+		for i, pos := range order {
+			x := bounds.Min.X + (pos % w)
+			y := bounds.Min.Y + (pos / w)
+			if i < switched {
+				dst.Set(x, y, to.At(x, y))
+			} else {
+				dst.Set(x, y, from.At(x, y))
+			}
+		}
+	}
+}
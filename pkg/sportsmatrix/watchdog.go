@@ -0,0 +1,82 @@
+package sportsmatrix
+
+import (
+	"context"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var defaultWatchdogTimeout = 10 * time.Minute
+
+// sdNotify sends a state notification to systemd. It does nothing when not
+// run as a systemd notify service
+func sdNotify(state string) error {
+	socket := os.Getenv("NOTIFY_SOCKET")
+	if socket == "" {
+		return nil
+	}
+
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Write([]byte(state))
+	return err
+}
+
+// watchdogInterval returns how often systemd expects watchdog pings, or 0 if the
+// watchdog isn't enabled for this process
+func watchdogInterval() time.Duration {
+	usec, err := strconv.ParseInt(os.Getenv("WATCHDOG_USEC"), 10, 64)
+	if err != nil || usec <= 0 {
+		return 0
+	}
+	if pid := os.Getenv("WATCHDOG_PID"); pid != "" && pid != strconv.Itoa(os.Getpid()) {
+		return 0
+	}
+
+	return time.Duration(usec) * time.Microsecond / 2
+}
+
+// watchdog pings the systemd watchdog for as long as the render loop is making
+// progress, so that a wedged render loop gets the service restarted
+func (s *SportsMatrix) watchdog(ctx context.Context) {
+	interval := watchdogInterval()
+	if interval == 0 {
+		return
+	}
+
+	s.log.Info("systemd watchdog enabled",
+		zap.Duration("interval", interval),
+		zap.Duration("timeout", s.cfg.watchdogTimeout),
+	)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		since := time.Since(s.health.lastBeat())
+		if s.screenIsOn.Load() && since > s.cfg.watchdogTimeout {
+			s.log.Error("render loop has stopped making progress, skipping watchdog ping",
+				zap.Duration("since", since),
+			)
+			continue
+		}
+
+		if err := sdNotify("WATCHDOG=1"); err != nil {
+			s.log.Error("failed to ping systemd watchdog", zap.Error(err))
+		}
+	}
+}
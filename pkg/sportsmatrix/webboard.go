@@ -14,9 +14,16 @@ import (
 )
 
 func (s *SportsMatrix) launchWebBoard(ctx context.Context) error {
+	scheme := "http"
+	if s.cfg.TLS != nil {
+		scheme = "https"
+	}
 	args := []string{
 		"--kiosk",
-		fmt.Sprintf("--app=http://localhost:%d/board", s.cfg.HTTPListenPort),
+		fmt.Sprintf("--app=%s://localhost:%d/board", scheme, s.cfg.HTTPListenPort),
+	}
+	if s.cfg.TLS != nil && s.cfg.TLS.SelfSigned {
+		args = append(args, "--ignore-certificate-errors")
 	}
 	cmd := exec.CommandContext(ctx, "/usr/bin/chromium-browser", args...)
 
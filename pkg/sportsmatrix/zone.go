@@ -0,0 +1,131 @@
+package sportsmatrix
+
+import (
+	"context"
+	"image"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/board"
+)
+
+var zoneIdleDelay = 1 * time.Second
+
+// Zone is a region of the matrix with its own board rotation. The zone without
+// any boards listed is used for the main rotation of all other boards
+type Zone struct {
+	Name   string   `json:"name"`
+	Left   int      `json:"left"`
+	Top    int      `json:"top"`
+	Width  int      `json:"width"`
+	Height int      `json:"height"`
+	Boards []string `json:"boards"`
+}
+
+type zone struct {
+	name        string
+	boards      []board.Board
+	canvases    []board.Canvas
+	boardCancel context.CancelFunc
+	sync.Mutex
+}
+
+// Bounds returns the region of a matrix with the given geometry for the zone. A width
+// or height of 0 extends the zone to the edge of the matrix
+func (z *Zone) Bounds(width int, height int) image.Rectangle {
+	maxX := width
+	if z.Width > 0 {
+		maxX = z.Left + z.Width
+	}
+	maxY := height
+	if z.Height > 0 {
+		maxY = z.Top + z.Height
+	}
+
+	return image.Rect(z.Left, z.Top, maxX, maxY)
+}
+
+// AddZone adds a zone that rotates through its own boards independently of
+// the main rotation, rendering to its own canvases
+func (s *SportsMatrix) AddZone(name string, canvases []board.Canvas, boards ...board.Board) {
+	s.zones = append(s.zones, &zone{
+		name:     name,
+		boards:   boards,
+		canvases: canvases,
+	})
+}
+
+func (s *SportsMatrix) zoneBoards() []board.Board {
+	var boards []board.Board
+	for _, z := range s.zones {
+		boards = append(boards, z.boards...)
+	}
+
+	return boards
+}
+
+func (s *SportsMatrix) serveZone(ctx context.Context, z *zone) {
+	s.log.Info("Serving zone",
+		zap.String("zone", z.name),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		start := time.Now()
+
+	BOARDS:
+		for _, b := range z.boards {
+			if !s.screenIsOn.Load() {
+				break BOARDS
+			}
+			if !b.Enabled() {
+				continue BOARDS
+			}
+
+			boardCtx, cancel := context.WithCancel(ctx)
+			z.Lock()
+			z.boardCancel = cancel
+			z.Unlock()
+
+			if err := s.renderBoard(boardCtx, b, z.canvases); err != nil {
+				s.log.Error("zone board render returned error",
+					zap.String("zone", z.name),
+					zap.String("board", b.Name()),
+					zap.Error(err),
+				)
+			}
+			cancel()
+		}
+
+		// Don't spin when there's nothing to show in this zone
+		if time.Since(start) < zoneIdleDelay {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(zoneIdleDelay):
+			}
+		}
+	}
+}
+
+// clearZones stops the current board in each zone and clears its canvases
+func (s *SportsMatrix) clearZones() {
+	for _, z := range s.zones {
+		z.Lock()
+		if z.boardCancel != nil {
+			z.boardCancel()
+		}
+		z.Unlock()
+
+		for _, canvas := range z.canvases {
+			_ = canvas.Clear()
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package statboard
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/boardtest"
+)
+
+type goldenPlayer struct {
+	first string
+	last  string
+	stats map[string]string
+}
+
+func (p *goldenPlayer) FirstName() string                     { return p.first }
+func (p *goldenPlayer) LastName() string                      { return p.last }
+func (p *goldenPlayer) GetStat(stat string) string            { return p.stats[stat] }
+func (p *goldenPlayer) Position() string                      { return "C" }
+func (p *goldenPlayer) GetCategory() string                   { return "skater" }
+func (p *goldenPlayer) UpdateStats(ctx context.Context) error { return nil }
+func (p *goldenPlayer) PrefixCol() string                     { return "" }
+func (p *goldenPlayer) StatColor(stat string) color.Color {
+	if stat == "PTS" {
+		return color.RGBA{255, 255, 0, 255}
+	}
+	return color.White
+}
+
+type goldenAPI struct {
+	players []*goldenPlayer
+}
+
+func (a *goldenAPI) FindPlayer(ctx context.Context, firstName string, lastName string) (Player, error) {
+	for _, p := range a.players {
+		if strings.EqualFold(p.first, firstName) && strings.EqualFold(p.last, lastName) {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("no player %s %s", firstName, lastName)
+}
+
+func (a *goldenAPI) GetPlayer(ctx context.Context, id string) (Player, error) {
+	return nil, fmt.Errorf("no player %s", id)
+}
+
+func (a *goldenAPI) AvailableStats(ctx context.Context, playerCategory string) ([]string, error) {
+	return []string{"GP", "G", "A", "PTS"}, nil
+}
+
+func (a *goldenAPI) ListPlayers(ctx context.Context, teamAbbreviation string) ([]Player, error) {
+	var players []Player
+	for _, p := range a.players {
+		players = append(players, p)
+	}
+	return players, nil
+}
+
+func (a *goldenAPI) StatShortName(stat string) string { return stat }
+func (a *goldenAPI) LeagueShortName() string          { return "NHL" }
+func (a *goldenAPI) HTTPPathPrefix() string           { return "nhl" }
+func (a *goldenAPI) PlayerCategories() []string       { return []string{"skater"} }
+
+func TestGolden(t *testing.T) {
+	api := &goldenAPI{
+		players: []*goldenPlayer{
+			{first: "Brad", last: "Marchand", stats: map[string]string{"GP": "70", "G": "32", "A": "48", "PTS": "80"}},
+			{first: "Patrice", last: "Bergeron", stats: map[string]string{"GP": "73", "G": "25", "A": "40", "PTS": "65"}},
+			{first: "David", last: "Pastrnak", stats: map[string]string{"GP": "72", "G": "40", "A": "37", "PTS": "77"}},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		config *Config
+	}{
+		{
+			name: "players",
+			config: &Config{
+				Players: []string{"Brad Marchand", "Patrice Bergeron", "David Pastrnak"},
+			},
+		},
+		{
+			name: "team",
+			config: &Config{
+				Teams: []string{"BOS"},
+				StatOverride: map[string][]string{
+					"skater": {"G", "PTS"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		for _, size := range boardtest.Geometries {
+			name := boardtest.Name("stats-"+test.name, size)
+			t.Run(name, func(t *testing.T) {
+				cfg := *test.config
+				cfg.Enabled = atomic.NewBool(true)
+				cfg.SetDefaults()
+
+				b, err := New(context.Background(), api, &cfg, zap.NewNop())
+				require.NoError(t, err)
+
+				boardtest.Golden(t, name, boardtest.Render(t, b, size))
+			})
+		}
+	}
+}
@@ -17,6 +17,7 @@ func (s *StatBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Handler: func(wrter http.ResponseWriter, req *http.Request) {
 				s.log.Info("disabling board", zap.String("board", s.Name()))
 				s.Disable()
+				board.RecordToggles(req.Context(), s.config.Enabled)
 			},
 		},
 		{
@@ -24,10 +25,12 @@ func (s *StatBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Handler: func(wrter http.ResponseWriter, req *http.Request) {
 				s.log.Info("enabling board", zap.String("board", s.Name()))
 				s.Enable()
+				board.RecordToggles(req.Context(), s.config.Enabled)
 			},
 		},
 		{
-			Path: fmt.Sprintf("/%s/stats/status", s.api.HTTPPathPrefix()),
+			Path:     fmt.Sprintf("/%s/stats/status", s.api.HTTPPathPrefix()),
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				s.log.Debug("get board status", zap.String("board", s.Name()))
 				w.Header().Set("Content-Type", "text/plain")
@@ -47,6 +50,7 @@ func (s *StatBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				default:
 				}
 				s.config.ScrollMode.Store(false)
+				board.RecordToggles(req.Context(), s.config.ScrollMode)
 			},
 		},
 		{
@@ -58,10 +62,12 @@ func (s *StatBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				default:
 				}
 				s.config.ScrollMode.Store(true)
+				board.RecordToggles(req.Context(), s.config.ScrollMode)
 			},
 		},
 		{
-			Path: fmt.Sprintf("/%s/stats/scrollstatus", s.api.HTTPPathPrefix()),
+			Path:     fmt.Sprintf("/%s/stats/scrollstatus", s.api.HTTPPathPrefix()),
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				s.log.Debug("get board status", zap.String("board", s.Name()))
 				w.Header().Set("Content-Type", "text/plain")
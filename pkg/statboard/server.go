@@ -9,6 +9,7 @@ import (
 	"github.com/twitchtv/twirp"
 
 	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+	"github.com/robbydyer/sports/pkg/board"
 )
 
 // Server ...
@@ -30,14 +31,17 @@ func (s *Server) SetStatus(ctx context.Context, req *pb.SetStatusReq) (*emptypb.
 	cancelBoard := false
 	if req.Status.Enabled {
 		if s.board.Enable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
 			cancelBoard = true
 		}
 	} else {
 		if s.board.Disable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
 			cancelBoard = true
 		}
 	}
 	if s.board.config.ScrollMode.CAS(!req.Status.ScrollEnabled, req.Status.ScrollEnabled) {
+		board.RecordToggles(ctx, s.board.config.ScrollMode)
 		cancelBoard = true
 	}
 
@@ -13,12 +13,12 @@ import (
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
-	"github.com/robfig/cron/v3"
 	"github.com/twitchtv/twirp"
 
 	pb "github.com/robbydyer/sports/internal/proto/basicboard"
 	"github.com/robbydyer/sports/pkg/board"
 	"github.com/robbydyer/sports/pkg/rgbrender"
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/twirphelpers"
 )
 
@@ -159,7 +159,7 @@ func New(ctx context.Context, api API, config *Config, logger *zap.Logger, opts
 	}
 
 	if len(config.OnTimes) > 1 || len(config.OffTimes) > 1 {
-		c := cron.New()
+		c := simclock.NewCron()
 
 		for _, off := range config.OffTimes {
 			s.log.Info("statboard will be scheduled to turn off",
@@ -230,6 +230,11 @@ func (s *StatBoard) Enabled() bool {
 	return s.config.Enabled.Load()
 }
 
+// EnabledToggle ...
+func (s *StatBoard) EnabledToggle() *atomic.Bool {
+	return s.config.Enabled
+}
+
 // Enable ...
 func (s *StatBoard) Enable() bool {
 	if s.config.Enabled.CAS(false, true) {
@@ -0,0 +1,101 @@
+package stockboard
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/boardtest"
+	"github.com/robbydyer/sports/pkg/simclock"
+)
+
+// goldenAPI serves a day of prices every 5 minutes, rising or falling from the open with a wiggle
+type goldenAPI struct {
+	open  time.Time
+	close time.Time
+	trend float64
+}
+
+func (a *goldenAPI) Get(ctx context.Context, symbols []string, interval time.Duration) ([]*Stock, error) {
+	var stocks []*Stock
+	for _, sym := range symbols {
+		s := &Stock{
+			Symbol:    sym,
+			OpenPrice: 100,
+		}
+		i := 0
+		for t := a.open; !t.After(a.close); t = t.Add(5 * time.Minute) {
+			s.Prices = append(s.Prices, &Price{
+				Time:  t,
+				Price: 100 + (a.trend * float64(i) / 10) + (2 * math.Sin(float64(i)/5)),
+			})
+			i++
+		}
+		s.Price = s.Prices[len(s.Prices)-1].Price
+		s.Change = s.Price - s.OpenPrice
+		stocks = append(stocks, s)
+	}
+
+	return stocks, nil
+}
+
+func (a *goldenAPI) TradingOpen() (time.Time, error)  { return a.open, nil }
+func (a *goldenAPI) TradingClose() (time.Time, error) { return a.close, nil }
+func (a *goldenAPI) CacheClear()                      {}
+
+func TestGolden(t *testing.T) {
+	open := time.Date(2021, time.October, 29, 9, 30, 0, 0, time.Local)
+	close := time.Date(2021, time.October, 29, 16, 0, 0, 0, time.Local)
+	clock, err := simclock.NewSimulated(close.Add(time.Hour), 1)
+	require.NoError(t, err)
+	simclock.Set(clock)
+	defer simclock.Set(nil)
+
+	tests := []struct {
+		name   string
+		symbol string
+		trend  float64
+		logos  bool
+	}{
+		{
+			name:   "up",
+			symbol: "AAPL",
+			trend:  1,
+		},
+		{
+			name:   "down-logo",
+			symbol: "AAPL",
+			trend:  -1,
+			logos:  true,
+		},
+	}
+
+	for _, test := range tests {
+		for _, size := range boardtest.Geometries {
+			name := boardtest.Name("stock-"+test.name, size)
+			t.Run(name, func(t *testing.T) {
+				cfg := &Config{
+					Enabled:  atomic.NewBool(true),
+					Symbols:  []string{test.symbol},
+					UseLogos: atomic.NewBool(test.logos),
+				}
+				cfg.SetDefaults()
+
+				api := &goldenAPI{
+					open:  open,
+					close: close,
+					trend: test.trend,
+				}
+				b, err := New(api, cfg, zap.NewNop())
+				require.NoError(t, err)
+
+				boardtest.Golden(t, name, boardtest.Render(t, b, size))
+			})
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package stockboard
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/simclock"
+)
+
+// UpdateConfig applies the config fields that can be changed while the board is running.
+// It returns the json names of the fields it handles
+func (s *StockBoard) UpdateConfig(config *Config) ([]string, error) {
+	s.Lock()
+	s.config.Symbols = config.Symbols
+	s.config.BoardDelay = config.BoardDelay
+	s.config.boardDelay = config.boardDelay
+	s.config.UpdateInterval = config.UpdateInterval
+	s.config.updateInterval = config.updateInterval
+	s.config.ScrollDelay = config.ScrollDelay
+	s.config.scrollDelay = config.scrollDelay
+	s.config.OnTimes = config.OnTimes
+	s.config.OffTimes = config.OffTimes
+	s.Unlock()
+
+	fields := []string{
+		"symbols",
+		"boardDelay",
+		"updateInterval",
+		"scrollDelay",
+		"onTimes",
+		"offTimes",
+	}
+
+	return fields, s.scheduleOnOff()
+}
+
+// scheduleOnOff sets the cron schedules for turning the board on and off, replacing
+// any previous schedules
+func (s *StockBoard) scheduleOnOff() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.onOffCron != nil {
+		s.onOffCron.Stop()
+		s.onOffCron = nil
+	}
+
+	if len(s.config.OnTimes) < 1 && len(s.config.OffTimes) < 1 {
+		return nil
+	}
+
+	c := simclock.NewCron()
+	for _, on := range s.config.OnTimes {
+		s.log.Info("stockboard will be schedule to turn on",
+			zap.String("turn on", on),
+		)
+		_, err := c.AddFunc(on, func() {
+			s.log.Info("stockboard turning on")
+			s.diagnostics.SetOffHours(false)
+			s.Enable()
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add cron for stockboard: %w", err)
+		}
+	}
+
+	for _, off := range s.config.OffTimes {
+		s.log.Info("stockboard will be schedule to turn off",
+			zap.String("turn on", off),
+		)
+		_, err := c.AddFunc(off, func() {
+			s.log.Info("stockboard turning off")
+			s.diagnostics.SetOffHours(true)
+			s.Disable()
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add cron for stockboard: %w", err)
+		}
+	}
+
+	c.Start()
+	s.onOffCron = c
+
+	return nil
+}
@@ -44,6 +44,8 @@ type StockBoard struct {
 	logos               map[string]*logo.Logo
 	logoLock            sync.Mutex
 	stateChangeNotifier board.StateChangeNotifier
+	onOffCron           *cron.Cron
+	diagnostics         board.DiagnosticsTracker
 	sync.Mutex
 }
 
@@ -162,35 +164,8 @@ func New(api API, config *Config, log *zap.Logger) (*StockBoard, error) {
 		),
 	)
 
-	if len(config.OffTimes) > 0 || len(config.OnTimes) > 0 {
-		c := cron.New()
-		for _, on := range config.OnTimes {
-			s.log.Info("stockboard will be schedule to turn on",
-				zap.String("turn on", on),
-			)
-			_, err := c.AddFunc(on, func() {
-				s.log.Info("stockboard turning on")
-				s.Enable()
-			})
-			if err != nil {
-				return nil, fmt.Errorf("failed to add cron for stockboard: %w", err)
-			}
-		}
-
-		for _, off := range config.OffTimes {
-			s.log.Info("stockboard will be schedule to turn off",
-				zap.String("turn on", off),
-			)
-			_, err := c.AddFunc(off, func() {
-				s.log.Info("stockboard turning off")
-				s.Disable()
-			})
-			if err != nil {
-				return nil, fmt.Errorf("failed to add cron for stockboard: %w", err)
-			}
-		}
-
-		c.Start()
+	if err := s.scheduleOnOff(); err != nil {
+		return nil, err
 	}
 
 	return s, nil
@@ -205,6 +180,16 @@ func (s *StockBoard) Enabled() bool {
 	return s.config.Enabled.Load()
 }
 
+// EnabledToggle ...
+func (s *StockBoard) EnabledToggle() *atomic.Bool {
+	return s.config.Enabled
+}
+
+// Diagnostics ...
+func (s *StockBoard) Diagnostics() *board.Diagnostics {
+	return s.diagnostics.Diagnostics()
+}
+
 // Enable ...
 func (s *StockBoard) Enable() bool {
 	if s.config.Enabled.CAS(false, true) {
@@ -294,15 +279,24 @@ func (s *StockBoard) render(ctx context.Context, canvas board.Canvas) (board.Can
 
 	go s.enablerCancel(boardCtx, boardCancel)
 
+	// These can change on a config reload
+	s.Lock()
+	symbols := s.config.Symbols
+	updateInterval := s.config.updateInterval
+	boardDelay := s.config.boardDelay
+	s.Unlock()
+
 	s.log.Debug("fetching stock info",
-		zap.Strings("stocks", s.config.Symbols),
-		zap.String("update interval str", s.config.updateInterval.String()),
-		zap.Duration("update interval", s.config.updateInterval),
+		zap.Strings("stocks", symbols),
+		zap.String("update interval str", updateInterval.String()),
+		zap.Duration("update interval", updateInterval),
 	)
-	stocks, err := s.api.Get(boardCtx, s.config.Symbols, s.config.updateInterval)
+	stocks, err := s.api.Get(boardCtx, symbols, updateInterval)
 	if err != nil {
+		s.diagnostics.Skipped(board.SkipAPIFailure)
 		return nil, err
 	}
+	s.diagnostics.DataLoaded()
 
 	var scrollCanvas *rgbmatrix.ScrollCanvas
 	if canvas.Scrollable() && s.config.ScrollMode.Load() {
@@ -345,7 +339,7 @@ STOCK:
 			select {
 			case <-boardCtx.Done():
 				return nil, context.Canceled
-			case <-time.After(s.config.boardDelay):
+			case <-time.After(boardDelay):
 			}
 		}
 	}
@@ -366,6 +360,7 @@ func (s *StockBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				s.log.Info("enabling board", zap.String("board", s.Name()))
 				s.Enable()
+				board.RecordToggles(req.Context(), s.config.Enabled)
 			},
 		},
 		{
@@ -377,11 +372,13 @@ func (s *StockBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				default:
 				}
 				s.Disable()
+				board.RecordToggles(req.Context(), s.config.Enabled)
 				s.cacheClear()
 			},
 		},
 		{
-			Path: "/stocks/status",
+			Path:     "/stocks/status",
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				s.log.Debug("get board status", zap.String("board", s.Name()))
 				w.Header().Set("Content-Type", "text/plain")
@@ -400,6 +397,7 @@ func (s *StockBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				default:
 				}
 				s.config.ScrollMode.Store(true)
+				board.RecordToggles(req.Context(), s.config.ScrollMode)
 				s.cacheClear()
 			},
 		},
@@ -407,6 +405,7 @@ func (s *StockBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Path: "/stocks/scrolloff",
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				s.config.ScrollMode.Store(false)
+				board.RecordToggles(req.Context(), s.config.ScrollMode)
 				select {
 				case s.cancelBoard <- struct{}{}:
 				default:
@@ -415,7 +414,8 @@ func (s *StockBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			},
 		},
 		{
-			Path: "/stocks/scrollstatus",
+			Path:     "/stocks/scrollstatus",
+			ReadOnly: true,
 			Handler: func(w http.ResponseWriter, req *http.Request) {
 				s.log.Debug("get board scroll status", zap.String("board", s.Name()))
 				w.Header().Set("Content-Type", "text/plain")
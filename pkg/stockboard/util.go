@@ -5,11 +5,11 @@ import (
 	"image"
 	"math"
 	"sort"
-	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/robbydyer/sports/pkg/rgbrender"
+	"github.com/robbydyer/sports/pkg/simclock"
 )
 
 func (s *Stock) minPrice() *Price {
@@ -244,7 +244,7 @@ func (s *StockBoard) chartWidth(totalWidth int) (int, error) {
 	}
 
 	totalTime := close.Sub(open)
-	passed := time.Since(open)
+	passed := simclock.Since(open)
 
 	val := int(math.Ceil((passed.Minutes() / totalTime.Minutes() * float64(totalWidth))))
 	if val > totalWidth || val < 1 {
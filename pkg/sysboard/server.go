@@ -9,6 +9,7 @@ import (
 	"github.com/twitchtv/twirp"
 
 	pb "github.com/robbydyer/sports/internal/proto/basicboard"
+	"github.com/robbydyer/sports/pkg/board"
 )
 
 // Server ...
@@ -28,9 +29,13 @@ func (s *Server) SetStatus(ctx context.Context, req *pb.SetStatusReq) (*emptypb.
 	}
 
 	if req.Status.Enabled {
-		s.board.Enable()
+		if s.board.Enable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
+		}
 	} else {
-		s.board.Disable()
+		if s.board.Disable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
+		}
 	}
 
 	return &emptypb.Empty{}, nil
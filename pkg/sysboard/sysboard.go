@@ -15,7 +15,6 @@ import (
 
 	"github.com/mackerelio/go-osstat/cpu"
 	"github.com/mackerelio/go-osstat/memory"
-	"github.com/robfig/cron/v3"
 	"github.com/twitchtv/twirp"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -23,6 +22,7 @@ import (
 	pb "github.com/robbydyer/sports/internal/proto/basicboard"
 	"github.com/robbydyer/sports/pkg/board"
 	"github.com/robbydyer/sports/pkg/rgbrender"
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/twirphelpers"
 )
 
@@ -83,7 +83,7 @@ func New(logger *zap.Logger, config *Config) (*SysBoard, error) {
 	)
 
 	if len(config.OffTimes) > 0 || len(config.OnTimes) > 0 {
-		c := cron.New()
+		c := simclock.NewCron()
 		for _, on := range config.OnTimes {
 			s.log.Info("sysboard will be schedule to turn on",
 				zap.String("turn on", on),
@@ -232,6 +232,11 @@ func (s *SysBoard) Enabled() bool {
 	return s.config.Enabled.Load()
 }
 
+// EnabledToggle ...
+func (s *SysBoard) EnabledToggle() *atomic.Bool {
+	return s.config.Enabled
+}
+
 // Enable ...
 func (s *SysBoard) Enable() bool {
 	if s.config.Enabled.CAS(false, true) {
@@ -268,20 +273,23 @@ func (s *SysBoard) SetStateChangeNotifier(st board.StateChangeNotifier) {
 func (s *SysBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 	disable := &board.HTTPHandler{
 		Path: "/sys/disable",
-		Handler: func(http.ResponseWriter, *http.Request) {
+		Handler: func(w http.ResponseWriter, req *http.Request) {
 			s.log.Info("disabling sys board")
 			s.Disable()
+			board.RecordToggles(req.Context(), s.config.Enabled)
 		},
 	}
 	enable := &board.HTTPHandler{
 		Path: "/sys/enable",
-		Handler: func(http.ResponseWriter, *http.Request) {
+		Handler: func(w http.ResponseWriter, req *http.Request) {
 			s.log.Info("enabling sys board")
 			s.Enable()
+			board.RecordToggles(req.Context(), s.config.Enabled)
 		},
 	}
 	status := &board.HTTPHandler{
-		Path: "/sys/status",
+		Path:     "/sys/status",
+		ReadOnly: true,
 		Handler: func(w http.ResponseWriter, req *http.Request) {
 			s.log.Debug("get board status", zap.String("board", s.Name()))
 			w.Header().Set("Content-Type", "text/plain")
@@ -13,7 +13,6 @@ import (
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
-	"github.com/robfig/cron/v3"
 	"github.com/twitchtv/twirp"
 
 	pb "github.com/robbydyer/sports/internal/proto/basicboard"
@@ -21,6 +20,7 @@ import (
 	"github.com/robbydyer/sports/pkg/logo"
 	"github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
 	"github.com/robbydyer/sports/pkg/rgbrender"
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/twirphelpers"
 )
 
@@ -120,7 +120,7 @@ func New(api API, config *Config, log *zap.Logger, opts ...OptionFunc) (*TextBoa
 	}
 
 	if len(config.OffTimes) > 0 || len(config.OnTimes) > 0 {
-		c := cron.New()
+		c := simclock.NewCron()
 		for _, on := range config.OnTimes {
 			s.log.Info("textboard will be schedule to turn on",
 				zap.String("turn on", on),
@@ -183,6 +183,11 @@ func (s *TextBoard) Enabled() bool {
 	return s.config.Enabled.Load()
 }
 
+// EnabledToggle ...
+func (s *TextBoard) EnabledToggle() *atomic.Bool {
+	return s.config.Enabled
+}
+
 // Enable ...
 func (s *TextBoard) Enable() bool {
 	if s.config.Enabled.CAS(false, true) {
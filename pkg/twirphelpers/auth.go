@@ -0,0 +1,235 @@
+package twirphelpers
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/board"
+)
+
+// Token roles
+const (
+	RoleReadOnly = "readonly"
+	RoleAdmin    = "admin"
+)
+
+// maxAuditBody is the most of a request body that's recorded in the audit log
+const maxAuditBody = 4096
+
+// APIToken is a named token allowed to call the API
+type APIToken struct {
+	Name  string `json:"name"`
+	Token string `json:"token"`
+	Role  string `json:"role"`
+}
+
+// AuthConfig ...
+type AuthConfig struct {
+	Tokens []*APIToken `json:"tokens"`
+	// AuditLog is a file that control actions are appended to. Defaults to the regular log
+	AuditLog string `json:"auditLog"`
+}
+
+// Auth authenticates API requests by token. A nil *Auth allows every request
+type Auth struct {
+	tokens []*APIToken
+	audit  *zap.Logger
+}
+
+type callerKey struct{}
+
+// caller is the token that authenticated a request
+type caller struct {
+	token  *APIToken
+	audit  *zap.Logger
+	remote string
+	params string
+}
+
+// NewAuth returns nil if no tokens are configured, which disables authentication
+func NewAuth(cfg *AuthConfig, logger *zap.Logger) (*Auth, error) {
+	if cfg == nil || len(cfg.Tokens) < 1 {
+		return nil, nil
+	}
+
+	seen := make(map[string]struct{}, len(cfg.Tokens))
+	for _, t := range cfg.Tokens {
+		if t.Name == "" {
+			return nil, fmt.Errorf("API tokens must have a name")
+		}
+		if t.Token == "" {
+			return nil, fmt.Errorf("API token %s is empty", t.Name)
+		}
+		if _, ok := seen[t.Token]; ok {
+			return nil, fmt.Errorf("API token %s is a duplicate", t.Name)
+		}
+		seen[t.Token] = struct{}{}
+
+		if t.Role == "" {
+			t.Role = RoleReadOnly
+		}
+		if t.Role != RoleReadOnly && t.Role != RoleAdmin {
+			return nil, fmt.Errorf("API token %s has invalid role %s, must be %s or %s", t.Name, t.Role, RoleReadOnly, RoleAdmin)
+		}
+	}
+
+	audit := logger.Named("audit")
+	if cfg.AuditLog != "" {
+		zc := zap.NewProductionConfig()
+		zc.OutputPaths = []string{cfg.AuditLog}
+		zc.Sampling = nil
+		zc.DisableCaller = true
+		zc.DisableStacktrace = true
+		var err error
+		audit, err = zc.Build()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open audit log %s: %w", cfg.AuditLog, err)
+		}
+	}
+
+	return &Auth{
+		tokens: cfg.Tokens,
+		audit:  audit,
+	}, nil
+}
+
+// Middleware rejects requests without a valid token. The token is accepted as a bearer token, or as
+// the password of basic auth so that browsers can prompt for it. Requests that public returns true
+// for are served without a token
+func (a *Auth) Middleware(next http.Handler, public func(*http.Request) bool) http.Handler {
+	if a == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if public != nil && public(req) {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		token := a.lookup(requestToken(req))
+		if token == nil {
+			w.Header().Set("WWW-Authenticate", `Basic realm="sportsmatrix"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		c := &caller{
+			token:  token,
+			audit:  a.audit,
+			remote: req.RemoteAddr,
+			params: req.URL.RawQuery,
+		}
+
+		if req.Body != nil && req.Method != http.MethodGet {
+			body, err := io.ReadAll(io.LimitReader(req.Body, maxAuditBody))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			req.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), req.Body))
+
+			if len(body) > 0 {
+				if strings.HasPrefix(req.Header.Get("Content-Type"), "application/json") {
+					c.params = strings.TrimSpace(string(body))
+				} else {
+					c.params = fmt.Sprintf("%d bytes of %s", len(body), req.Header.Get("Content-Type"))
+				}
+			}
+		}
+
+		next.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), callerKey{}, c)))
+	})
+}
+
+func (a *Auth) lookup(token string) *APIToken {
+	if token == "" {
+		return nil
+	}
+	var found *APIToken
+	for _, t := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(t.Token), []byte(token)) == 1 {
+			found = t
+		}
+	}
+	return found
+}
+
+func requestToken(req *http.Request) string {
+	if _, pass, ok := req.BasicAuth(); ok {
+		return pass
+	}
+	if auth := req.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	}
+	return ""
+}
+
+// AuthorizeHandler checks the role of the request's token for a plain HTTP handler, and records
+// control actions in the audit log
+func AuthorizeHandler(h *board.HTTPHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if err := authorize(req.Context(), h.Path, h.ReadOnly); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		h.Handler(w, req)
+	}
+}
+
+// authorizeRPC checks the role of the request's token for a twirp method, and records control
+// actions in the audit log
+func authorizeRPC(ctx context.Context) error {
+	method, _ := twirp.MethodName(ctx)
+	svc, _ := twirp.ServiceName(ctx)
+	pkg, _ := twirp.PackageName(ctx)
+
+	if err := authorize(ctx, fmt.Sprintf("%s.%s/%s", pkg, svc, method), readOnlyMethod(method)); err != nil {
+		return twirp.NewError(twirp.PermissionDenied, err.Error())
+	}
+
+	return nil
+}
+
+func authorize(ctx context.Context, method string, readOnly bool) error {
+	c, ok := ctx.Value(callerKey{}).(*caller)
+	if !ok {
+		// Authentication is disabled
+		return nil
+	}
+
+	if readOnly {
+		return nil
+	}
+
+	if c.token.Role != RoleAdmin {
+		c.audit.Warn("denied API call",
+			zap.String("token", c.token.Name),
+			zap.String("method", method),
+			zap.String("remote", c.remote),
+		)
+		return fmt.Errorf("token %s is not allowed to call %s", c.token.Name, method)
+	}
+
+	c.audit.Info("API call",
+		zap.String("token", c.token.Name),
+		zap.String("method", method),
+		zap.String("params", c.params),
+		zap.String("remote", c.remote),
+	)
+
+	return nil
+}
+
+// readOnlyMethod returns true for twirp methods that don't change anything
+func readOnlyMethod(method string) bool {
+	return strings.HasPrefix(method, "Get") || method == "Version"
+}
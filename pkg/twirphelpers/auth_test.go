@@ -0,0 +1,106 @@
+package twirphelpers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/twitchtv/twirp"
+	"github.com/twitchtv/twirp/ctxsetters"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/robbydyer/sports/pkg/board"
+)
+
+func TestAuth(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+
+	auth, err := NewAuth(&AuthConfig{
+		Tokens: []*APIToken{
+			{Name: "dashboard", Token: "read"},
+			{Name: "phone", Token: "write", Role: RoleAdmin},
+		},
+	}, zap.New(core))
+	require.NoError(t, err)
+
+	var rpcErr error
+	mux := http.NewServeMux()
+	for _, h := range []*board.HTTPHandler{
+		{Path: "/api/status", ReadOnly: true},
+		{Path: "/api/screenoff"},
+		{Path: "/api/imgcanvas/stream", ReadOnly: true, Public: true},
+	} {
+		h.Handler = func(w http.ResponseWriter, req *http.Request) {}
+		mux.HandleFunc(h.Path, AuthorizeHandler(h))
+	}
+	// The web UI is served without a token
+	mux.HandleFunc("/board", func(w http.ResponseWriter, req *http.Request) {})
+	mux.HandleFunc("/rpc/", func(w http.ResponseWriter, req *http.Request) {
+		ctx := ctxsetters.WithPackageName(req.Context(), "matrix.v1")
+		ctx = ctxsetters.WithServiceName(ctx, "Sportsmatrix")
+		ctx = ctxsetters.WithMethodName(ctx, strings.TrimPrefix(req.URL.Path, "/rpc/"))
+		_, rpcErr = GetDefaultHooks(nil, zap.NewNop()).RequestRouted(ctx)
+	})
+	h := auth.Middleware(mux, func(req *http.Request) bool {
+		return req.URL.Path == "/board" || req.URL.Path == "/api/imgcanvas/stream"
+	})
+
+	do := func(path string, token string) int {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"board": "nhl"}`))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	require.Equal(t, http.StatusUnauthorized, do("/api/status", ""))
+	require.Equal(t, http.StatusUnauthorized, do("/api/status", "wrong"))
+	require.Equal(t, http.StatusOK, do("/api/status", "read"))
+	require.Equal(t, http.StatusForbidden, do("/api/screenoff", "read"))
+	require.Equal(t, http.StatusOK, do("/api/screenoff", "write"))
+
+	// The web board kiosk can't log in
+	require.Equal(t, http.StatusOK, do("/board", ""))
+	require.Equal(t, http.StatusOK, do("/api/imgcanvas/stream", ""))
+	require.Equal(t, http.StatusOK, do("/api/imgcanvas/stream", "read"))
+
+	require.Equal(t, http.StatusOK, do("/rpc/GetStatus", "read"))
+	require.NoError(t, rpcErr)
+	require.Equal(t, http.StatusOK, do("/rpc/Jump", "read"))
+	var twerr twirp.Error
+	require.ErrorAs(t, rpcErr, &twerr)
+	require.Equal(t, twirp.PermissionDenied, twerr.Code())
+	require.Equal(t, http.StatusOK, do("/rpc/Jump", "write"))
+	require.NoError(t, rpcErr)
+
+	calls := logs.FilterMessage("API call").All()
+	require.Len(t, calls, 2)
+	require.Equal(t, "phone", calls[1].ContextMap()["token"])
+	require.Equal(t, "matrix.v1.Sportsmatrix/Jump", calls[1].ContextMap()["method"])
+	require.Equal(t, `{"board": "nhl"}`, calls[1].ContextMap()["params"])
+	require.Len(t, logs.FilterMessage("denied API call").All(), 2)
+
+	// Basic auth is accepted so browsers can prompt for the token
+	req := httptest.NewRequest(http.MethodGet, "/api/status", nil)
+	req.SetBasicAuth("anyone", "read")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// No tokens disables authentication
+	auth, err = NewAuth(&AuthConfig{}, zap.NewNop())
+	require.NoError(t, err)
+	require.Nil(t, auth)
+	w = httptest.NewRecorder()
+	auth.Middleware(mux, nil).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/screenoff", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	_, err = NewAuth(&AuthConfig{Tokens: []*APIToken{{Name: "bad", Token: "x", Role: "root"}}}, zap.NewNop())
+	require.Error(t, err)
+}
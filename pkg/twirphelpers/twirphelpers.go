@@ -9,7 +9,8 @@ import (
 	"github.com/robbydyer/sports/pkg/board"
 )
 
-// GetDefaultHooks returns default custom twirp.ServerHooks
+// GetDefaultHooks returns default custom twirp.ServerHooks. Requests authenticated by
+// Auth.Middleware are checked against their token's role
 func GetDefaultHooks(board board.Board, logger *zap.Logger) *twirp.ServerHooks {
 	boardName := ""
 	if board != nil {
@@ -27,6 +28,10 @@ func GetDefaultHooks(board board.Board, logger *zap.Logger) *twirp.ServerHooks {
 				zap.String("board", boardName),
 			)
 
+			if err := authorizeRPC(ctx); err != nil {
+				return ctx, err
+			}
+
 			return ctx, nil
 		},
 		Error: func(ctx context.Context, err twirp.Error) context.Context {
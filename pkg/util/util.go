@@ -8,15 +8,18 @@ import (
 	"net/http"
 	"os"
 	"time"
+
+	"github.com/robbydyer/sports/pkg/httpclient"
+	"github.com/robbydyer/sports/pkg/simclock"
 )
 
 // Today is sometimes actually yesterday
 func Today() []time.Time {
-	if time.Now().Local().Hour() < 4 {
-		return []time.Time{time.Now().AddDate(0, 0, -1).Local()}
+	if simclock.Now().Local().Hour() < 4 {
+		return []time.Time{simclock.Now().AddDate(0, 0, -1).Local()}
 	}
 
-	return []time.Time{time.Now().Local()}
+	return []time.Time{simclock.Now().Local()}
 }
 
 // NCAAFToday takes a single "today" time and adds thurs-sat games for the coming week.
@@ -103,7 +106,7 @@ func PullPng(ctx context.Context, url string) (image.Image, error) {
 	if err != nil {
 		return nil, err
 	}
-	client := http.DefaultClient
+	client := httpclient.Client("images")
 
 	req = req.WithContext(ctx)
 
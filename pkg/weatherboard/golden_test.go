@@ -0,0 +1,118 @@
+package weatherboard
+
+import (
+	"context"
+	"image"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/boardtest"
+	"github.com/robbydyer/sports/pkg/simclock"
+)
+
+type goldenAPI struct {
+	now time.Time
+}
+
+func fltPtr(f float64) *float64 {
+	return &f
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func (a *goldenAPI) CurrentForecast(ctx context.Context, zipCode string, country string, bounds image.Rectangle, metric bool) (*Forecast, error) {
+	return &Forecast{
+		Time:         a.now,
+		Temperature:  fltPtr(72),
+		Humidity:     50,
+		TempUnit:     "F",
+		IconCode:     "02d",
+		PrecipChance: intPtr(20),
+	}, nil
+}
+
+func (a *goldenAPI) DailyForecasts(ctx context.Context, zipCode string, country string, bounds image.Rectangle, metric bool) ([]*Forecast, error) {
+	return []*Forecast{
+		{
+			Time:     a.now,
+			HighTemp: fltPtr(75),
+			LowTemp:  fltPtr(60),
+			TempUnit: "F",
+			IconCode: "01d",
+		},
+		{
+			Time:         a.now.Add(24 * time.Hour),
+			HighTemp:     fltPtr(58),
+			LowTemp:      fltPtr(41),
+			Humidity:     80,
+			TempUnit:     "F",
+			IconCode:     "10d",
+			PrecipChance: intPtr(90),
+		},
+	}, nil
+}
+
+func (a *goldenAPI) HourlyForecasts(ctx context.Context, zipCode string, country string, bounds image.Rectangle, metric bool) ([]*Forecast, error) {
+	return []*Forecast{
+		{
+			Time:         a.now.Add(3 * time.Hour),
+			Temperature:  fltPtr(64),
+			TempUnit:     "F",
+			IconCode:     "13n",
+			IsHourly:     true,
+			PrecipChance: intPtr(40),
+		},
+	}, nil
+}
+
+func (a *goldenAPI) CacheClear() {}
+
+func TestGolden(t *testing.T) {
+	now := time.Date(2021, time.October, 30, 12, 0, 0, 0, time.Local)
+	clock, err := simclock.NewSimulated(now, 1)
+	require.NoError(t, err)
+	simclock.Set(clock)
+	defer simclock.Set(nil)
+
+	tests := []struct {
+		name   string
+		config func(c *Config)
+	}{
+		{
+			name:   "current",
+			config: func(c *Config) { c.CurrentForecast.Store(true) },
+		},
+		{
+			name:   "hourly",
+			config: func(c *Config) { c.HourlyForecast.Store(true) },
+		},
+		{
+			name:   "daily",
+			config: func(c *Config) { c.DailyForecast.Store(true) },
+		},
+	}
+
+	for _, test := range tests {
+		for _, size := range boardtest.Geometries {
+			name := boardtest.Name("weather-"+test.name, size)
+			t.Run(name, func(t *testing.T) {
+				cfg := &Config{
+					Enabled: atomic.NewBool(true),
+				}
+				cfg.SetDefaults()
+				test.config(cfg)
+
+				b, err := New(&goldenAPI{now: now}, cfg, zap.NewNop())
+				require.NoError(t, err)
+
+				boardtest.Golden(t, name, boardtest.Render(t, b, size))
+			})
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package weatherboard
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/simclock"
+)
+
+// UpdateConfig applies the config fields that can be changed while the board is running.
+// It returns the json names of the fields it handles
+func (w *WeatherBoard) UpdateConfig(config *Config) ([]string, error) {
+	w.Lock()
+	w.config.ZipCode = config.ZipCode
+	w.config.Country = config.Country
+	w.config.BoardDelay = config.BoardDelay
+	w.config.boardDelay = config.boardDelay
+	w.config.ScrollDelay = config.ScrollDelay
+	w.config.scrollDelay = config.scrollDelay
+	w.config.DailyNumber = config.DailyNumber
+	w.config.HourlyNumber = config.HourlyNumber
+	w.config.OnTimes = config.OnTimes
+	w.config.OffTimes = config.OffTimes
+	w.Unlock()
+
+	fields := []string{
+		"zipCode",
+		"country",
+		"boardDelay",
+		"scrollDelay",
+		"dailyNumber",
+		"hourlyNumber",
+		"onTimes",
+		"offTimes",
+	}
+
+	return fields, w.scheduleOnOff()
+}
+
+// scheduleOnOff sets the cron schedules for turning the board on and off, replacing
+// any previous schedules
+func (w *WeatherBoard) scheduleOnOff() error {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.onOffCron != nil {
+		w.onOffCron.Stop()
+		w.onOffCron = nil
+	}
+
+	if len(w.config.OnTimes) < 1 && len(w.config.OffTimes) < 1 {
+		return nil
+	}
+
+	c := simclock.NewCron()
+	for _, on := range w.config.OnTimes {
+		w.log.Info("weatherboard will be schedule to turn on",
+			zap.String("turn on", on),
+		)
+		_, err := c.AddFunc(on, func() {
+			w.log.Info("weatherboard turning on")
+			w.diagnostics.SetOffHours(false)
+			w.Enable()
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add cron for weatherboard: %w", err)
+		}
+	}
+
+	for _, off := range w.config.OffTimes {
+		w.log.Info("weatherboard will be schedule to turn off",
+			zap.String("turn on", off),
+		)
+		_, err := c.AddFunc(off, func() {
+			w.log.Info("weatherboard turning off")
+			w.diagnostics.SetOffHours(true)
+			w.Disable()
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add cron for weatherboard: %w", err)
+		}
+	}
+
+	c.Start()
+	w.onOffCron = c
+
+	return nil
+}
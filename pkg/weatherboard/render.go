@@ -12,6 +12,7 @@ import (
 
 	"github.com/robbydyer/sports/pkg/board"
 	"github.com/robbydyer/sports/pkg/rgbrender"
+	"github.com/robbydyer/sports/pkg/simclock"
 )
 
 const sectionBufferRatio = 0.0625
@@ -117,7 +118,7 @@ func (w *WeatherBoard) drawForecast(ctx context.Context, canvas board.Canvas, f
 
 	if f.IsHourly {
 		timeStr = f.Time.Format("3:04PM")
-	} else if f.Time.YearDay() != time.Now().Local().YearDay() {
+	} else if f.Time.YearDay() != simclock.Now().Local().YearDay() {
 		_, mo, day := f.Time.Date()
 		wkd := f.Time.Weekday()
 		timeStr = fmt.Sprintf("%d/%d %s", mo, day, shortWeekday(wkd))
@@ -9,6 +9,7 @@ import (
 	"github.com/twitchtv/twirp"
 
 	pb "github.com/robbydyer/sports/internal/proto/weatherboard"
+	"github.com/robbydyer/sports/pkg/board"
 )
 
 // Server ...
@@ -30,20 +31,25 @@ func (s *Server) SetStatus(ctx context.Context, req *pb.SetStatusReq) (*emptypb.
 	cancelBoard := false
 	if req.Status.Enabled {
 		if s.board.Enable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
 			cancelBoard = true
 		}
 	} else {
 		if s.board.Disable() {
+			board.RecordToggles(ctx, s.board.config.Enabled)
 			cancelBoard = true
 		}
 	}
 	if s.board.config.ScrollMode.CAS(!req.Status.ScrollEnabled, req.Status.ScrollEnabled) {
+		board.RecordToggles(ctx, s.board.config.ScrollMode)
 		cancelBoard = true
 	}
 	if s.board.config.DailyForecast.CAS(!req.Status.DailyEnabled, req.Status.DailyEnabled) {
+		board.RecordToggles(ctx, s.board.config.DailyForecast)
 		cancelBoard = true
 	}
 	if s.board.config.HourlyForecast.CAS(!req.Status.HourlyEnabled, req.Status.HourlyEnabled) {
+		board.RecordToggles(ctx, s.board.config.HourlyForecast)
 		cancelBoard = true
 	}
 
@@ -21,6 +21,7 @@ import (
 	"github.com/robbydyer/sports/pkg/logo"
 	"github.com/robbydyer/sports/pkg/rgbmatrix-rpi"
 	"github.com/robbydyer/sports/pkg/rgbrender"
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/twirphelpers"
 )
 
@@ -37,6 +38,8 @@ type WeatherBoard struct {
 	smallWriter         *rgbrender.TextWriter
 	rpcServer           pb.TwirpServer
 	stateChangeNotifier board.StateChangeNotifier
+	onOffCron           *cron.Cron
+	diagnostics         board.DiagnosticsTracker
 	sync.Mutex
 }
 
@@ -158,35 +161,8 @@ func New(api API, config *Config, log *zap.Logger) (*WeatherBoard, error) {
 		),
 	)
 
-	if len(config.OffTimes) > 0 || len(config.OnTimes) > 0 {
-		c := cron.New()
-		for _, on := range config.OnTimes {
-			s.log.Info("weatherboard will be schedule to turn on",
-				zap.String("turn on", on),
-			)
-			_, err := c.AddFunc(on, func() {
-				s.log.Info("weatherboard turning on")
-				s.Enable()
-			})
-			if err != nil {
-				return nil, fmt.Errorf("failed to add cron for weatherboard: %w", err)
-			}
-		}
-
-		for _, off := range config.OffTimes {
-			s.log.Info("weatherboard will be schedule to turn off",
-				zap.String("turn on", off),
-			)
-			_, err := c.AddFunc(off, func() {
-				s.log.Info("weatherboard turning off")
-				s.Disable()
-			})
-			if err != nil {
-				return nil, fmt.Errorf("failed to add cron for weatherboard: %w", err)
-			}
-		}
-
-		c.Start()
+	if err := s.scheduleOnOff(); err != nil {
+		return nil, err
 	}
 
 	return s, nil
@@ -201,6 +177,16 @@ func (w *WeatherBoard) Enabled() bool {
 	return w.config.Enabled.Load()
 }
 
+// EnabledToggle ...
+func (w *WeatherBoard) EnabledToggle() *atomic.Bool {
+	return w.config.Enabled
+}
+
+// Diagnostics ...
+func (w *WeatherBoard) Diagnostics() *board.Diagnostics {
+	return w.diagnostics.Diagnostics()
+}
+
 // Enable ...
 func (w *WeatherBoard) Enable() bool {
 	if w.config.Enabled.CAS(false, true) {
@@ -295,6 +281,12 @@ func (w *WeatherBoard) render(ctx context.Context, canvas board.Canvas) (board.C
 
 	go w.enablerCancel(boardCtx, boardCancel)
 
+	// These can change on a config reload
+	w.Lock()
+	zipCode, country := w.config.ZipCode, w.config.Country
+	hourlyNumber, dailyNumber := w.config.HourlyNumber, w.config.DailyNumber
+	w.Unlock()
+
 	var scrollCanvas *rgbmatrix.ScrollCanvas
 	base, ok := canvas.(*rgbmatrix.ScrollCanvas)
 	if ok && w.config.ScrollMode.Load() {
@@ -309,25 +301,27 @@ func (w *WeatherBoard) render(ctx context.Context, canvas board.Canvas) (board.C
 	zeroed := rgbrender.ZeroedBounds(canvas.Bounds())
 	forecasts := []*Forecast{}
 	if w.config.CurrentForecast.Load() {
-		f, err := w.api.CurrentForecast(ctx, w.config.ZipCode, w.config.Country, zeroed, w.config.MetricUnits.Load())
+		f, err := w.api.CurrentForecast(ctx, zipCode, country, zeroed, w.config.MetricUnits.Load())
 		if err != nil {
+			w.diagnostics.Skipped(board.SkipAPIFailure)
 			return nil, err
 		}
 		forecasts = append(forecasts, f)
 	}
 	if w.config.HourlyForecast.Load() {
-		fs, err := w.api.HourlyForecasts(ctx, w.config.ZipCode, w.config.Country, zeroed, w.config.MetricUnits.Load())
+		fs, err := w.api.HourlyForecasts(ctx, zipCode, country, zeroed, w.config.MetricUnits.Load())
 		if err != nil {
+			w.diagnostics.Skipped(board.SkipAPIFailure)
 			return nil, err
 		}
 		// sortForecasts(fs)
 		w.log.Debug("found hourly forecasts",
 			zap.Int("num", len(fs)),
-			zap.Int("max show", w.config.HourlyNumber),
+			zap.Int("max show", hourlyNumber),
 		)
 		if len(fs) > 0 {
 		HOURLY:
-			for i := 0; i < w.config.HourlyNumber; i++ {
+			for i := 0; i < hourlyNumber; i++ {
 				if len(fs) <= i {
 					break HOURLY
 				}
@@ -337,19 +331,20 @@ func (w *WeatherBoard) render(ctx context.Context, canvas board.Canvas) (board.C
 	}
 
 	if w.config.DailyForecast.Load() {
-		fs, err := w.api.DailyForecasts(ctx, w.config.ZipCode, w.config.Country, zeroed, w.config.MetricUnits.Load())
+		fs, err := w.api.DailyForecasts(ctx, zipCode, country, zeroed, w.config.MetricUnits.Load())
 		if err != nil {
+			w.diagnostics.Skipped(board.SkipAPIFailure)
 			return nil, err
 		}
 		w.log.Debug("found daily forecasts",
 			zap.Int("num", len(fs)),
-			zap.Int("max show", w.config.DailyNumber),
+			zap.Int("max show", dailyNumber),
 		)
 
 		// Drop today's forecast, as it's redundant
 	TODAYCHECK:
 		for i := range fs {
-			if fs[i].Time.YearDay() == time.Now().Local().YearDay() {
+			if fs[i].Time.YearDay() == simclock.Now().Local().YearDay() {
 				// delete this element
 				fs = append(fs[:i], fs[i+1:]...)
 				break TODAYCHECK
@@ -357,7 +352,7 @@ func (w *WeatherBoard) render(ctx context.Context, canvas board.Canvas) (board.C
 		}
 		if len(fs) > 0 {
 		DAILY:
-			for i := 0; i < w.config.DailyNumber; i++ {
+			for i := 0; i < dailyNumber; i++ {
 				if len(fs) <= i {
 					break DAILY
 				}
@@ -365,6 +360,7 @@ func (w *WeatherBoard) render(ctx context.Context, canvas board.Canvas) (board.C
 			}
 		}
 	}
+	w.diagnostics.DataLoaded()
 
 FORECASTS:
 	for _, f := range forecasts {
@@ -395,6 +391,7 @@ func (w *WeatherBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Handler: func(wrtr http.ResponseWriter, req *http.Request) {
 				w.log.Info("enabling board", zap.String("board", w.Name()))
 				w.Enable()
+				board.RecordToggles(req.Context(), w.config.Enabled)
 			},
 		},
 		{
@@ -406,11 +403,13 @@ func (w *WeatherBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				default:
 				}
 				w.Disable()
+				board.RecordToggles(req.Context(), w.config.Enabled)
 				w.cacheClear()
 			},
 		},
 		{
-			Path: "/weather/status",
+			Path:     "/weather/status",
+			ReadOnly: true,
 			Handler: func(wrtr http.ResponseWriter, req *http.Request) {
 				w.log.Debug("get board status", zap.String("board", w.Name()))
 				wrtr.Header().Set("Content-Type", "text/plain")
@@ -429,6 +428,7 @@ func (w *WeatherBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				default:
 				}
 				w.config.ScrollMode.Store(true)
+				board.RecordToggles(req.Context(), w.config.ScrollMode)
 				w.cacheClear()
 			},
 		},
@@ -436,6 +436,7 @@ func (w *WeatherBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Path: "/weather/scrolloff",
 			Handler: func(wrtr http.ResponseWriter, req *http.Request) {
 				w.config.ScrollMode.Store(false)
+				board.RecordToggles(req.Context(), w.config.ScrollMode)
 				select {
 				case w.cancelBoard <- struct{}{}:
 				default:
@@ -444,7 +445,8 @@ func (w *WeatherBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			},
 		},
 		{
-			Path: "/weather/scrollstatus",
+			Path:     "/weather/scrollstatus",
+			ReadOnly: true,
 			Handler: func(wrtr http.ResponseWriter, req *http.Request) {
 				w.log.Debug("get board scroll status", zap.String("board", w.Name()))
 				wrtr.Header().Set("Content-Type", "text/plain")
@@ -470,6 +472,7 @@ func (w *WeatherBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Handler: func(wrtr http.ResponseWriter, req *http.Request) {
 				w.log.Info("enabling board", zap.String("board", w.Name()))
 				w.config.DailyForecast.Store(true)
+				board.RecordToggles(req.Context(), w.config.DailyForecast)
 			},
 		},
 		{
@@ -481,10 +484,12 @@ func (w *WeatherBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				default:
 				}
 				w.config.DailyForecast.Store(false)
+				board.RecordToggles(req.Context(), w.config.DailyForecast)
 			},
 		},
 		{
-			Path: "/weather/dailystatus",
+			Path:     "/weather/dailystatus",
+			ReadOnly: true,
 			Handler: func(wrtr http.ResponseWriter, req *http.Request) {
 				w.log.Debug("get board status", zap.String("board", w.Name()))
 				wrtr.Header().Set("Content-Type", "text/plain")
@@ -500,6 +505,7 @@ func (w *WeatherBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 			Handler: func(wrtr http.ResponseWriter, req *http.Request) {
 				w.log.Info("enabling board", zap.String("board", w.Name()))
 				w.config.HourlyForecast.Store(true)
+				board.RecordToggles(req.Context(), w.config.HourlyForecast)
 			},
 		},
 		{
@@ -511,10 +517,12 @@ func (w *WeatherBoard) GetHTTPHandlers() ([]*board.HTTPHandler, error) {
 				default:
 				}
 				w.config.HourlyForecast.Store(false)
+				board.RecordToggles(req.Context(), w.config.HourlyForecast)
 			},
 		},
 		{
-			Path: "/weather/hourlystatus",
+			Path:     "/weather/hourlystatus",
+			ReadOnly: true,
 			Handler: func(wrtr http.ResponseWriter, req *http.Request) {
 				w.log.Debug("get board status", zap.String("board", w.Name()))
 				wrtr.Header().Set("Content-Type", "text/plain")
@@ -0,0 +1,266 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/metrics"
+	"github.com/robbydyer/sports/pkg/sportboard"
+)
+
+// Payload formats
+const (
+	FormatJSON    = "json"
+	FormatNtfy    = "ntfy"
+	FormatGotify  = "gotify"
+	FormatDiscord = "discord"
+)
+
+var (
+	defaultRetries    = 3
+	defaultRetryDelay = 5 * time.Second
+	defaultTimeout    = 10 * time.Second
+)
+
+// Hook is an HTTP POST sent for game events
+type Hook struct {
+	retryDelay time.Duration
+	timeout    time.Duration
+	tmpl       *template.Template
+	Name       string            `json:"name"`
+	URL        string            `json:"url"`
+	Format     string            `json:"format"`
+	Template   string            `json:"template"`
+	Headers    map[string]string `json:"headers"`
+	Events     []string          `json:"events"`
+	Leagues    []string          `json:"leagues"`
+	Retries    *int              `json:"retries"`
+	RetryDelay string            `json:"retryDelay"`
+	Timeout    string            `json:"timeout"`
+}
+
+// Notifier sends game events to webhooks
+type Notifier struct {
+	hooks  []*Hook
+	client *http.Client
+	log    *zap.Logger
+}
+
+type gotifyPayload struct {
+	Title    string `json:"title"`
+	Message  string `json:"message"`
+	Priority int    `json:"priority"`
+}
+
+type discordPayload struct {
+	Content string `json:"content"`
+}
+
+// SetDefaults ...
+func (h *Hook) SetDefaults() {
+	if h.Format == "" {
+		h.Format = FormatJSON
+	}
+	if h.Name == "" {
+		h.Name = h.URL
+	}
+	if h.Retries == nil {
+		r := defaultRetries
+		h.Retries = &r
+	}
+	h.retryDelay = parseDuration(h.RetryDelay, defaultRetryDelay)
+	h.timeout = parseDuration(h.Timeout, defaultTimeout)
+}
+
+func parseDuration(s string, def time.Duration) time.Duration {
+	if s == "" {
+		return def
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return def
+	}
+	return d
+}
+
+// New validates the hooks and parses their templates
+func New(hooks []*Hook, logger *zap.Logger) (*Notifier, error) {
+	for _, h := range hooks {
+		h.SetDefaults()
+
+		if h.URL == "" {
+			return nil, fmt.Errorf("webhook %s has no URL", h.Name)
+		}
+		switch h.Format {
+		case FormatJSON, FormatNtfy, FormatGotify, FormatDiscord:
+		default:
+			return nil, fmt.Errorf("webhook %s has unsupported format %s", h.Name, h.Format)
+		}
+		for _, e := range h.Events {
+			switch e {
+			case sportboard.GameStartEvent, sportboard.ScoreEvent, sportboard.PeriodEndEvent, sportboard.FinalEvent:
+			default:
+				return nil, fmt.Errorf("webhook %s has unsupported event %s", h.Name, e)
+			}
+		}
+		if h.Template != "" {
+			t, err := template.New(h.Name).Parse(h.Template)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse template for webhook %s: %w", h.Name, err)
+			}
+			h.tmpl = t
+		}
+	}
+
+	return &Notifier{
+		hooks:  hooks,
+		client: metrics.Client("webhook"),
+		log:    logger,
+	}, nil
+}
+
+// Notify sends the event to every hook that wants it. Hooks are sent in the background
+func (n *Notifier) Notify(event *sportboard.GameEvent) {
+	for _, h := range n.hooks {
+		if !h.wants(event) {
+			continue
+		}
+		go func(h *Hook) {
+			if err := n.send(context.Background(), h, event); err != nil {
+				n.log.Error("failed to send webhook",
+					zap.String("webhook", h.Name),
+					zap.String("event", event.Type),
+					zap.Error(err),
+				)
+			}
+		}(h)
+	}
+}
+
+func (h *Hook) wants(event *sportboard.GameEvent) bool {
+	return matches(h.Events, event.Type) && matches(h.Leagues, event.League)
+}
+
+// matches returns true if the list is empty or contains the value
+func matches(list []string, val string) bool {
+	if len(list) < 1 {
+		return true
+	}
+	for _, l := range list {
+		if strings.EqualFold(l, val) {
+			return true
+		}
+	}
+	return false
+}
+
+// send posts the event, retrying with backoff on connection errors, 429s and 5xx responses
+func (n *Notifier) send(ctx context.Context, h *Hook, event *sportboard.GameEvent) error {
+	body, contentType, err := h.payload(event)
+	if err != nil {
+		return err
+	}
+
+	delay := h.retryDelay
+	for attempt := 0; ; attempt++ {
+		retry, err := n.post(ctx, h, body, contentType, event)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= *h.Retries {
+			return err
+		}
+
+		n.log.Warn("retrying webhook",
+			zap.String("webhook", h.Name),
+			zap.Int("attempt", attempt+1),
+			zap.Error(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+func (n *Notifier) post(ctx context.Context, h *Hook, body []byte, contentType string, event *sportboard.GameEvent) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if h.Format == FormatNtfy && h.tmpl == nil {
+		req.Header.Set("Title", event.Title())
+		req.Header.Set("Tags", "sports")
+	}
+	for k, v := range h.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+
+	err = fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500, err
+}
+
+// payload returns the body and content type for the event
+func (h *Hook) payload(event *sportboard.GameEvent) ([]byte, string, error) {
+	if h.tmpl != nil {
+		var buf bytes.Buffer
+		if err := h.tmpl.Execute(&buf, event); err != nil {
+			return nil, "", fmt.Errorf("failed to execute template for webhook %s: %w", h.Name, err)
+		}
+		contentType := "text/plain"
+		if h.Format != FormatNtfy {
+			contentType = "application/json"
+		}
+		return buf.Bytes(), contentType, nil
+	}
+
+	var v interface{}
+	switch h.Format {
+	case FormatNtfy:
+		return []byte(event.Message()), "text/plain", nil
+	case FormatGotify:
+		v = &gotifyPayload{
+			Title:    event.Title(),
+			Message:  event.Message(),
+			Priority: 5,
+		}
+	case FormatDiscord:
+		v = &discordPayload{
+			Content: fmt.Sprintf("**%s**\n%s", event.Title(), event.Message()),
+		}
+	default:
+		v = event
+	}
+
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, "application/json", nil
+}
@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/robbydyer/sports/pkg/sportboard"
+)
+
+func TestSend(t *testing.T) {
+	event := &sportboard.GameEvent{
+		Type:        sportboard.ScoreEvent,
+		League:      "NHL",
+		HomeTeam:    "BOS",
+		AwayTeam:    "NYR",
+		HomeScore:   2,
+		AwayScore:   1,
+		ScoringTeam: "BOS",
+		Period:      "3rd",
+	}
+
+	attempts := atomic.NewInt32(0)
+	var body string
+	var contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if attempts.Inc() < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		b, _ := ioutil.ReadAll(req.Body)
+		body = string(b)
+		contentType = req.Header.Get("Content-Type")
+	}))
+	defer server.Close()
+
+	retries := 2
+	hooks := []*Hook{
+		{
+			URL:        server.URL,
+			Format:     FormatDiscord,
+			Retries:    &retries,
+			RetryDelay: "1ms",
+		},
+	}
+	n, err := New(hooks, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	require.NoError(t, n.send(context.Background(), hooks[0], event))
+	require.Equal(t, int32(3), attempts.Load())
+	require.Equal(t, "application/json", contentType)
+	require.JSONEq(t, `{"content": "**NYR @ BOS**\nBOS scored! NYR 1 - BOS 2 (3rd)"}`, body)
+
+	// Out of retries
+	attempts.Store(0)
+	retries = 1
+	require.Error(t, n.send(context.Background(), hooks[0], event))
+	require.Equal(t, int32(2), attempts.Load())
+}
+
+func TestPayload(t *testing.T) {
+	event := &sportboard.GameEvent{
+		Type:      sportboard.FinalEvent,
+		League:    "NHL",
+		HomeTeam:  "BOS",
+		AwayTeam:  "NYR",
+		HomeScore: 2,
+		AwayScore: 1,
+	}
+
+	hooks := []*Hook{
+		{
+			URL:      "http://localhost",
+			Template: `{"text": "{{ .AwayTeam }} at {{ .HomeTeam }}: {{ .Message }}"}`,
+		},
+		{
+			URL:    "http://localhost",
+			Format: FormatGotify,
+			Events: []string{sportboard.FinalEvent},
+		},
+		{
+			URL:     "http://localhost",
+			Format:  FormatNtfy,
+			Leagues: []string{"nfl"},
+		},
+	}
+	_, err := New(hooks, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	body, _, err := hooks[0].payload(event)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"text": "NYR at BOS: Final: NYR 1 - BOS 2"}`, string(body))
+
+	body, _, err = hooks[1].payload(event)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"title": "NYR @ BOS", "message": "Final: NYR 1 - BOS 2", "priority": 5}`, string(body))
+
+	require.True(t, hooks[1].wants(event))
+	require.False(t, hooks[2].wants(event))
+
+	_, err = New([]*Hook{{URL: "http://localhost", Format: "slack"}}, zaptest.NewLogger(t))
+	require.Error(t, err)
+	_, err = New([]*Hook{{URL: "http://localhost", Events: []string{"kickoff"}}}, zaptest.NewLogger(t))
+	require.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/stockboard"
 )
 
@@ -38,15 +39,16 @@ func (a *API) getCache(symbol string, expire time.Duration) *stockboard.Stock {
 		)
 	}
 
+	// Trading hours are checked on the simulated clock, if one is set, as the board shows that day
 	if beginErr == nil && endErr == nil {
-		t := time.Now()
+		t := simclock.Now()
 		loc, err := tradingLocation()
 		if err != nil {
 			a.log.Error("failed to get trading day location",
 				zap.Error(err),
 			)
 		} else {
-			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+			t = t.In(loc)
 			if t.After(end) {
 				// Do at least one update after trading hours end
 				if !a.afterHoursUpdated.Load() {
@@ -71,6 +73,7 @@ func (a *API) getCache(symbol string, expire time.Duration) *stockboard.Stock {
 		}
 	}
 
+	// Expiry is in real time, as it limits how often the API is called
 	if c.time.Add(expire).Before(time.Now()) {
 		a.log.Info("cache expired",
 			zap.String("symbol", symbol),
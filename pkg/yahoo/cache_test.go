@@ -0,0 +1,41 @@
+package yahoo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/robbydyer/sports/pkg/simclock"
+	"github.com/robbydyer/sports/pkg/stockboard"
+)
+
+func TestGetCacheSimulated(t *testing.T) {
+	loc, err := tradingLocation()
+	require.NoError(t, err)
+
+	a, err := New(zap.NewNop())
+	require.NoError(t, err)
+	stock := &stockboard.Stock{Symbol: "AAPL"}
+	a.setCache(stock)
+
+	simulate := func(hour int) {
+		clock, err := simclock.NewSimulated(time.Date(2021, 3, 2, hour, 0, 0, 0, loc), 1)
+		require.NoError(t, err)
+		simclock.Set(clock)
+	}
+	defer simclock.Set(nil)
+
+	// During trading hours on the simulated day, the cache expires as usual
+	simulate(12)
+	require.Equal(t, stock, a.getCache("AAPL", time.Hour))
+	require.Nil(t, a.getCache("AAPL", -time.Second))
+
+	// Outside trading hours it isn't expired, after one update once trading ends
+	simulate(6)
+	require.Equal(t, stock, a.getCache("AAPL", -time.Second))
+	simulate(20)
+	require.Nil(t, a.getCache("AAPL", -time.Second))
+	require.Equal(t, stock, a.getCache("AAPL", -time.Second))
+}
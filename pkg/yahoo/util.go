@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"regexp"
 	"time"
+
+	"github.com/robbydyer/sports/pkg/simclock"
 )
 
 var interval = regexp.MustCompile(`[0-9]+[a-z]+`)
@@ -20,19 +22,21 @@ func tradingLocation() (*time.Location, error) {
 }
 
 func tradingEnd() (time.Time, error) {
-	t := time.Now()
+	t := simclock.Now()
 	loc, err := tradingLocation()
 	if err != nil {
 		return t, err
 	}
+	t = t.In(loc)
 	return time.Date(t.Year(), t.Month(), t.Day(), 16, 30, 0, 0, loc), nil
 }
 
 func tradingBegin() (time.Time, error) {
-	t := time.Now()
+	t := simclock.Now()
 	loc, err := tradingLocation()
 	if err != nil {
 		return t, err
 	}
+	t = t.In(loc)
 	return time.Date(t.Year(), t.Month(), t.Day(), 8, 0o0, 0, 0, loc), nil
 }
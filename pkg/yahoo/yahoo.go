@@ -14,8 +14,9 @@ import (
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
-	"github.com/robfig/cron/v3"
-
+	"github.com/robbydyer/sports/pkg/httpclient"
+	"github.com/robbydyer/sports/pkg/metrics"
+	"github.com/robbydyer/sports/pkg/simclock"
 	"github.com/robbydyer/sports/pkg/stockboard"
 )
 
@@ -63,7 +64,7 @@ func New(log *zap.Logger) (*API, error) {
 		afterHoursUpdated: atomic.NewBool(false),
 	}
 
-	c := cron.New()
+	c := simclock.NewCron()
 
 	if _, err := c.AddFunc("00 01 * * *", func() {
 		a.afterHoursUpdated.Store(false)
@@ -115,11 +116,13 @@ func (a *API) TradingClose() (time.Time, error) {
 func (a *API) getTicker(ctx context.Context, ticker string, interval time.Duration) (*stockboard.Stock, error) {
 	cacheExpire := interval * 2
 	if stock := a.getCache(ticker, cacheExpire); stock != nil {
+		metrics.CacheHit("yahoo")
 		a.log.Debug("get stock from cache",
 			zap.String("symbol", ticker),
 		)
 		return stock, nil
 	}
+	metrics.CacheMiss("yahoo")
 
 	uri, err := url.Parse(fmt.Sprintf("%s/v8/finance/chart/%s", baseURL, ticker))
 	if err != nil {
@@ -142,7 +145,7 @@ func (a *API) getTicker(ctx context.Context, ticker string, interval time.Durati
 	if err != nil {
 		return nil, err
 	}
-	client := http.DefaultClient
+	client := httpclient.Client("yahoo")
 
 	req = req.WithContext(ctx)
 
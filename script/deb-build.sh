@@ -37,7 +37,10 @@ After=network.target
 StartLimitIntervalSec=0
 
 [Service]
-Type=simple
+Type=notify
+NotifyAccess=main
+TimeoutStartSec=300
+WatchdogSec=60
 Restart=always
 RestartSec=1
 User=root
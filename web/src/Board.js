@@ -3,29 +3,46 @@ import 'bootstrap/dist/css/bootstrap.min.css';
 import Container from 'react-bootstrap/Container';
 import Row from 'react-bootstrap/Row';
 import Col from 'react-bootstrap/Col';
-import Image from 'react-bootstrap/Image';
 
 var BACKEND = "http://" + window.location.host
 class Board extends React.Component {
     constructor(props) {
         super(props);
-        this.state = {
-            t: Date.now(),
-        }
+        this.canvas = React.createRef();
     }
 
     componentDidMount() {
         document.body.style.backgroundColor = "black"
-        this.interval = setInterval(() => this.setState({ t: Date.now() }), 2000)
+        this.stream = new EventSource(`${BACKEND}/api/imgcanvas/stream?scale=1&fps=15`);
+        this.stream.addEventListener("frame", (e) => this.drawFrame(JSON.parse(e.data)));
     }
     componentWillUnmount() {
-        clearInterval(this.interval)
+        this.stream.close()
         fetch(`${BACKEND}/api/imgcanvas/disable`, {
             method: "GET",
             mode: "cors",
         });
         document.body.style.backgroundColor = "white"
     }
+    drawFrame(frame) {
+        const canvas = this.canvas.current;
+        if (!canvas) {
+            return
+        }
+        canvas.width = frame.width;
+        canvas.height = frame.height;
+
+        const rgb = atob(frame.rgb);
+        const ctx = canvas.getContext("2d");
+        const img = ctx.createImageData(frame.width, frame.height);
+        for (let i = 0, j = 0; i < rgb.length; i += 3, j += 4) {
+            img.data[j] = rgb.charCodeAt(i);
+            img.data[j + 1] = rgb.charCodeAt(i + 1);
+            img.data[j + 2] = rgb.charCodeAt(i + 2);
+            img.data[j + 3] = 255;
+        }
+        ctx.putImageData(img, 0, 0);
+    }
     render() {
         return (
             <>
@@ -35,11 +52,11 @@ class Board extends React.Component {
                     }}
                 />
                 <Container fluid>
-                    <Row className="text-center"><Col><Image src={`${BACKEND}/api/imgcanvas/board?${this.state.t}`} style={{ height: 'auto', width: 'auto' }} name={this.state.t} fluid /></Col></Row>
+                    <Row className="text-center"><Col><canvas ref={this.canvas} style={{ width: '100%', imageRendering: 'pixelated' }} /></Col></Row>
                 </Container>
             </>
         )
     }
 }
 
-export default Board;
\ No newline at end of file
+export default Board;